@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	acmTypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+// ACMCertificateRenewal summarizes managed renewal, calling out the domains whose DNS validation is failing
+type ACMCertificateRenewal struct {
+	*ui.Text
+	view.ACM
+	repo           *repo.ACM
+	certificateArn string
+	app            *Application
+}
+
+func NewACMCertificateRenewal(repo *repo.ACM, certificateArn string, app *Application) *ACMCertificateRenewal {
+	a := &ACMCertificateRenewal{
+		Text:           ui.NewText(false, ""),
+		repo:           repo,
+		certificateArn: certificateArn,
+		app:            app,
+	}
+	return a
+}
+
+func (a ACMCertificateRenewal) GetLabels() []string {
+	arn, err := arn.Parse(a.certificateArn)
+	if err != nil {
+		panic(err)
+	}
+	return []string{utils.GetResourceNameFromArn(arn), "Renewal"}
+}
+
+func (a ACMCertificateRenewal) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func writeDomainValidation(sb *strings.Builder, v acmTypes.DomainValidation) {
+	sb.WriteString("  " + utils.DerefString(v.DomainName, "") + " (" + utils.AutoCase(string(v.ValidationStatus)) + ")\n")
+	if v.ResourceRecord != nil {
+		sb.WriteString("    " + utils.DerefString(v.ResourceRecord.Name, "") + " " + string(v.ResourceRecord.Type) + " " + utils.DerefString(v.ResourceRecord.Value, "") + "\n")
+	}
+}
+
+func (a ACMCertificateRenewal) Render() {
+	cert, err := a.repo.DescribeCertificate(a.certificateArn)
+	if err != nil {
+		panic(err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Renewal Eligibility: " + utils.AutoCase(string(cert.RenewalEligibility)) + "\n")
+	if cert.RenewalSummary == nil {
+		sb.WriteString("\nNo managed renewal is in progress.\n")
+		a.SetText(sb.String())
+		return
+	}
+
+	summary := cert.RenewalSummary
+	sb.WriteString("Renewal Status: " + utils.AutoCase(string(summary.RenewalStatus)) + "\n")
+	if summary.RenewalStatusReason != "" {
+		sb.WriteString("Reason: " + utils.AutoCase(string(summary.RenewalStatusReason)) + "\n")
+	}
+	if summary.UpdatedAt != nil {
+		sb.WriteString("Updated: " + summary.UpdatedAt.Format(utils.DefaultTimeFormat) + "\n")
+	}
+
+	var failed, other []acmTypes.DomainValidation
+	for _, v := range summary.DomainValidationOptions {
+		if v.ValidationMethod == acmTypes.ValidationMethodDns && v.ValidationStatus == acmTypes.DomainStatusFailed {
+			failed = append(failed, v)
+		} else {
+			other = append(other, v)
+		}
+	}
+	if len(failed) > 0 {
+		sb.WriteString("\nFailed DNS validations (check that these CNAME records exist):\n")
+		for _, v := range failed {
+			writeDomainValidation(&sb, v)
+		}
+	}
+	if len(other) > 0 {
+		sb.WriteString("\nOther validations:\n")
+		for _, v := range other {
+			writeDomainValidation(&sb, v)
+		}
+	}
+	a.SetText(sb.String())
+}
@@ -1,9 +1,14 @@
 package internal
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	acmTypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
 	"github.com/bporter816/aws-tui/internal/model"
 	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
 	"github.com/bporter816/aws-tui/internal/view"
@@ -13,12 +18,14 @@ import (
 type ACMCertificates struct {
 	*ui.Table
 	view.ACM
-	repo  *repo.ACM
-	app   *Application
-	model []model.ACMCertificate
+	repo     *repo.ACM
+	r53Repo  *repo.Route53
+	app      *Application
+	model    []model.ACMCertificate
+	settings *settings.Settings
 }
 
-func NewACMCertificates(repo *repo.ACM, app *Application) *ACMCertificates {
+func NewACMCertificates(repo *repo.ACM, r53Repo *repo.Route53, settings *settings.Settings, app *Application) *ACMCertificates {
 	a := &ACMCertificates{
 		Table: ui.NewTable([]string{
 			"ID",
@@ -28,9 +35,13 @@ func NewACMCertificates(repo *repo.ACM, app *Application) *ACMCertificates {
 			"STATUS",
 			"IN USE",
 			"RENEWAL ELIGIBILITY",
+			"EXPIRES",
+			"DAYS LEFT",
 		}, 1, 0),
-		repo: repo,
-		app:  app,
+		repo:     repo,
+		r53Repo:  r53Repo,
+		app:      app,
+		settings: settings,
 	}
 	return a
 }
@@ -50,6 +61,61 @@ func (a ACMCertificates) certificateHandler() {
 	}
 }
 
+func (a ACMCertificates) renewalHandler() {
+	row, err := a.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if arn := a.model[row-1].CertificateArn; arn != nil {
+		renewalView := NewACMCertificateRenewal(a.repo, *arn, a.app)
+		a.app.AddAndSwitch(renewalView)
+	}
+}
+
+func (a ACMCertificates) validationRecordsHandler() {
+	row, err := a.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if arn := a.model[row-1].CertificateArn; arn != nil {
+		validationView := NewACMValidationRecords(a.repo, a.r53Repo, *arn, a.app)
+		a.app.AddAndSwitch(validationView)
+	}
+}
+
+func (a *ACMCertificates) requestHandler() {
+	requestForm := NewACMRequestCertificateForm(a.repo, a.app, func() {
+		a.Render()
+	})
+	a.app.AddAndSwitch(requestForm)
+}
+
+func (a *ACMCertificates) importHandler() {
+	importForm := NewACMImportCertificateForm(a.repo, "", a.settings, a.app, func() {
+		a.Render()
+	})
+	a.app.AddAndSwitch(importForm)
+}
+
+func (a *ACMCertificates) reimportHandler() {
+	row, err := a.GetRowSelection()
+	if err != nil {
+		return
+	}
+	certificate := a.model[row-1]
+	if certificate.CertificateArn == nil {
+		return
+	}
+	if certificate.Type != acmTypes.CertificateTypeImported {
+		a.app.ShowError(a.GetService(), "Only imported certificates can be reimported, ACM renews the certificates it issues")
+		return
+	}
+	importForm := NewACMImportCertificateForm(a.repo, *certificate.CertificateArn, a.settings, a.app, func() {
+		a.Render()
+	})
+	a.app.AddAndSwitch(importForm)
+}
+
 func (a ACMCertificates) tagsHandler() {
 	row, err := a.GetRowSelection()
 	if err != nil {
@@ -61,13 +127,38 @@ func (a ACMCertificates) tagsHandler() {
 	}
 }
 
-func (a ACMCertificates) GetKeyActions() []KeyAction {
+func (a *ACMCertificates) GetKeyActions() []KeyAction {
 	return []KeyAction{
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'c', tcell.ModNone),
 			Description: "Certificate",
 			Action:      a.certificateHandler,
 		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone),
+			Description: "Renewal",
+			Action:      a.renewalHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone),
+			Description: "Validation Records",
+			Action:      a.validationRecordsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'n', tcell.ModNone),
+			Description: "Request",
+			Action:      a.requestHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'i', tcell.ModNone),
+			Description: "Import",
+			Action:      a.importHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'R', tcell.ModNone),
+			Description: "Reimport",
+			Action:      a.reimportHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
@@ -83,9 +174,13 @@ func (a *ACMCertificates) Render() {
 	}
 	a.model = model
 
+	now := time.Now()
+	warningDays, criticalDays := a.settings.GetACMExpiryThresholds()
 	var data [][]string
+	var colors []tcell.Color
 	for _, v := range model {
 		var id, inUse string
+		expires, daysLeft, color := "-", "-", tcell.ColorDefault
 		if v.CertificateArn != nil {
 			arn, err := arn.Parse(*v.CertificateArn)
 			if err == nil {
@@ -95,6 +190,17 @@ func (a *ACMCertificates) Render() {
 		if v.InUse != nil {
 			inUse = utils.BoolToString(*v.InUse, "Yes", "No")
 		}
+		// certificates that haven't been issued yet have no expiration
+		if v.NotAfter != nil {
+			days := utils.GetDaysRemaining(*v.NotAfter, now)
+			expires = v.NotAfter.Format(utils.DefaultTimeFormat)
+			daysLeft = strconv.Itoa(days)
+			if days <= criticalDays {
+				color = tcell.ColorRed
+			} else if days <= warningDays {
+				color = tcell.ColorYellow
+			}
+		}
 		data = append(data, []string{
 			id,
 			utils.DerefString(v.DomainName, ""),
@@ -103,7 +209,17 @@ func (a *ACMCertificates) Render() {
 			utils.AutoCase(string(v.Status)),
 			inUse,
 			string(v.RenewalEligibility),
+			expires,
+			daysLeft,
 		})
+		colors = append(colors, color)
 	}
 	a.SetData(data)
+
+	// the expiration columns are the last two
+	for i, color := range colors {
+		for _, col := range []int{len(data[i]) - 2, len(data[i]) - 1} {
+			a.GetCell(i+1, col).SetTextColor(color)
+		}
+	}
 }
@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// ACMImportCertificateForm imports a certificate, or reimports over an existing imported certificate to renew it
+type ACMImportCertificateForm struct {
+	*tview.Form
+	view.ACM
+	repo           *repo.ACM
+	certificateArn string
+	settings       *settings.Settings
+	app            *Application
+	onComplete     func()
+}
+
+func NewACMImportCertificateForm(repo *repo.ACM, certificateArn string, settings *settings.Settings, app *Application, onComplete func()) *ACMImportCertificateForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitleColor(tcell.ColorGreen)
+
+	a := &ACMImportCertificateForm{
+		Form:           form,
+		repo:           repo,
+		certificateArn: certificateArn,
+		settings:       settings,
+		app:            app,
+		onComplete:     onComplete,
+	}
+
+	form.AddInputField("Certificate", "", 60, nil, nil)
+	form.AddInputField("Private Key", "", 60, nil, nil)
+	form.AddInputField("Certificate Chain (optional)", "", 60, nil, nil)
+	form.AddButton("Select Certificate", func() { a.selectFileHandler(0) })
+	form.AddButton("Select Key", func() { a.selectFileHandler(1) })
+	form.AddButton("Select Chain", func() { a.selectFileHandler(2) })
+	if certificateArn == "" {
+		form.SetTitle(" Import Certificate ")
+		form.AddButton("Import", a.importHandler)
+	} else {
+		form.SetTitle(" Reimport Certificate ")
+		form.AddButton("Reimport", a.importHandler)
+	}
+	form.AddButton("Cancel", a.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return a
+}
+
+// selectFileHandler picks a file for the given field from the local directory
+func (a *ACMImportCertificateForm) selectFileHandler(item int) {
+	field := a.GetFormItem(item).(*tview.InputField)
+	fileSelector := ui.NewFileSelector(a.settings.GetLocalDirectory(), func(filePath string) {
+		field.SetText(filePath)
+		a.app.Close()
+	})
+	a.app.AddAndSwitch(&ComponentWrapper{
+		Primitive: fileSelector,
+		service:   a.GetService(),
+		labels:    []string{"Select " + strings.TrimSuffix(field.GetLabel(), " (optional)")},
+	})
+}
+
+func (a *ACMImportCertificateForm) readFile(item int) ([]byte, error) {
+	path := strings.TrimSpace(a.GetFormItem(item).(*tview.InputField).GetText())
+	if path == "" {
+		return nil, nil
+	}
+	return os.ReadFile(path)
+}
+
+func (a *ACMImportCertificateForm) importHandler() {
+	var files [3][]byte
+	for i := range files {
+		data, err := a.readFile(i)
+		if err != nil {
+			a.app.ShowError(a.GetService(), fmt.Sprintf("Read failed: %v", err))
+			return
+		}
+		files[i] = data
+	}
+	certificate, privateKey, chain := files[0], files[1], files[2]
+	if len(certificate) == 0 || len(privateKey) == 0 {
+		a.app.ShowError(a.GetService(), "Certificate and private key are required")
+		return
+	}
+	if err := utils.ValidateCertificateImport(certificate, privateKey, chain); err != nil {
+		a.app.ShowError(a.GetService(), err.Error())
+		return
+	}
+
+	if _, err := a.repo.ImportCertificate(a.certificateArn, certificate, privateKey, chain); err != nil {
+		a.app.ShowError(a.GetService(), fmt.Sprintf("Import certificate failed: %v", err))
+		return
+	}
+
+	a.app.Close()
+	if a.onComplete != nil {
+		a.onComplete()
+	}
+}
+
+func (a *ACMImportCertificateForm) cancelHandler() {
+	a.app.Close()
+}
+
+func (a ACMImportCertificateForm) GetLabels() []string {
+	if a.certificateArn == "" {
+		return []string{"Import Certificate"}
+	}
+	arn, err := arn.Parse(a.certificateArn)
+	if err != nil {
+		panic(err)
+	}
+	return []string{utils.GetResourceNameFromArn(arn), "Reimport"}
+}
+
+func (a ACMImportCertificateForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (a ACMImportCertificateForm) Render() {
+}
@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	acmTypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var acmValidationMethods = []acmTypes.ValidationMethod{
+	acmTypes.ValidationMethodDns,
+	acmTypes.ValidationMethodEmail,
+}
+
+type ACMRequestCertificateForm struct {
+	*tview.Form
+	view.ACM
+	repo       *repo.ACM
+	app        *Application
+	onComplete func()
+}
+
+func NewACMRequestCertificateForm(repo *repo.ACM, app *Application, onComplete func()) *ACMRequestCertificateForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Request Public Certificate ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	a := &ACMRequestCertificateForm{
+		Form:       form,
+		repo:       repo,
+		app:        app,
+		onComplete: onComplete,
+	}
+
+	var validationMethods []string
+	for _, v := range acmValidationMethods {
+		validationMethods = append(validationMethods, string(v))
+	}
+
+	form.AddInputField("Domain Name", "", 60, nil, nil)
+	form.AddTextArea("Additional Names (one per line)", "", 60, 6, 0, nil)
+	form.AddDropDown("Validation Method", validationMethods, 0, nil)
+	form.AddButton("Request", a.requestHandler)
+	form.AddButton("Cancel", a.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return a
+}
+
+func (a *ACMRequestCertificateForm) requestHandler() {
+	domainName := strings.TrimSpace(a.GetFormItem(0).(*tview.InputField).GetText())
+	if domainName == "" {
+		a.app.ShowError(a.GetService(), "Domain name is required")
+		return
+	}
+	var names []string
+	for _, v := range strings.FieldsFunc(a.GetFormItem(1).(*tview.TextArea).GetText(), func(r rune) bool {
+		return r == '\n' || r == ',' || r == ' '
+	}) {
+		if v != domainName {
+			names = append(names, v)
+		}
+	}
+	// the domain name has to be included in the alternative names when there are any
+	if len(names) > 0 {
+		names = append([]string{domainName}, names...)
+	}
+	index, _ := a.GetFormItem(2).(*tview.DropDown).GetCurrentOption()
+	validationMethod := acmValidationMethods[index]
+
+	if _, err := a.repo.RequestCertificate(domainName, names, validationMethod); err != nil {
+		a.app.ShowError(a.GetService(), fmt.Sprintf("Request certificate failed: %v", err))
+		return
+	}
+
+	a.app.Close()
+	if a.onComplete != nil {
+		a.onComplete()
+	}
+	if validationMethod == acmTypes.ValidationMethodDns {
+		a.app.ShowMessage(a.GetService(), "Requested a certificate for "+domainName+". Create its validation records to have it issued.")
+	} else {
+		a.app.ShowMessage(a.GetService(), "Requested a certificate for "+domainName+". Approve the validation emails to have it issued.")
+	}
+}
+
+func (a *ACMRequestCertificateForm) cancelHandler() {
+	a.app.Close()
+}
+
+func (a ACMRequestCertificateForm) GetLabels() []string {
+	return []string{"Request Certificate"}
+}
+
+func (a ACMRequestCertificateForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (a ACMRequestCertificateForm) Render() {
+}
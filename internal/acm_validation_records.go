@@ -0,0 +1,221 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	acmTypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+const (
+	acmValidationRecordPresent   = "Yes"
+	acmValidationRecordMissing   = "Missing"
+	acmValidationRecordDifferent = "Different value"
+	acmValidationRecordNoZone    = "No hosted zone"
+)
+
+type acmValidationRecord struct {
+	domains []string
+	record  acmTypes.ResourceRecord
+	status  acmTypes.DomainStatus
+	zone    *model.Route53HostedZone
+	state   string
+}
+
+// ACMValidationRecords shows the CNAME records for DNS validation and whether they exist in a matching hosted zone
+type ACMValidationRecords struct {
+	*ui.Table
+	view.ACM
+	repo           *repo.ACM
+	r53Repo        *repo.Route53
+	certificateArn string
+	app            *Application
+	records        []acmValidationRecord
+}
+
+func NewACMValidationRecords(repo *repo.ACM, r53Repo *repo.Route53, certificateArn string, app *Application) *ACMValidationRecords {
+	a := &ACMValidationRecords{
+		Table: ui.NewTable([]string{
+			"DOMAINS",
+			"VALIDATION",
+			"RECORD NAME",
+			"TYPE",
+			"VALUE",
+			"HOSTED ZONE",
+			"IN ZONE",
+		}, 1, 0),
+		repo:           repo,
+		r53Repo:        r53Repo,
+		certificateArn: certificateArn,
+		app:            app,
+	}
+	return a
+}
+
+func (a ACMValidationRecords) GetLabels() []string {
+	arn, err := arn.Parse(a.certificateArn)
+	if err != nil {
+		panic(err)
+	}
+	return []string{utils.GetResourceNameFromArn(arn), "Validation Records"}
+}
+
+func (a *ACMValidationRecords) createMissingHandler() {
+	changesByZone := make(map[string][]r53Types.Change)
+	var zoneIds []string
+	count := 0
+	for _, v := range a.records {
+		if v.state != acmValidationRecordMissing {
+			continue
+		}
+		zoneId := *v.zone.Id
+		if _, ok := changesByZone[zoneId]; !ok {
+			zoneIds = append(zoneIds, zoneId)
+		}
+		changesByZone[zoneId] = append(changesByZone[zoneId], r53Types.Change{
+			Action: r53Types.ChangeActionCreate,
+			ResourceRecordSet: &r53Types.ResourceRecordSet{
+				Name:            v.record.Name,
+				Type:            r53Types.RRType(v.record.Type),
+				TTL:             aws.Int64(300),
+				ResourceRecords: []r53Types.ResourceRecord{{Value: v.record.Value}},
+			},
+		})
+		count++
+	}
+	if count == 0 {
+		a.app.ShowMessage(a.GetService(), "There are no missing validation records that can be created")
+		return
+	}
+
+	msg := fmt.Sprintf("Create %v missing validation records in %v hosted zones?", count, len(zoneIds))
+	a.app.Confirm(a.GetService(), msg, "Create", func() {
+		for _, zoneId := range zoneIds {
+			changeInfo, err := a.r53Repo.ChangeRecords(zoneId, changesByZone[zoneId], "ACM validation records")
+			if err != nil {
+				a.app.ShowError(a.GetService(), fmt.Sprintf("Create records in %v failed: %v", zoneId, err))
+				a.Render()
+				return
+			}
+			trackRoute53Change(a.r53Repo, utils.DerefString(changeInfo.Id, ""), a.app)
+		}
+		a.Render()
+	})
+}
+
+func (a *ACMValidationRecords) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'c', tcell.ModNone),
+			Description: "Create Missing Records",
+			Action:      a.createMissingHandler,
+		},
+	}
+}
+
+// getRecordState reports whether the validation record exists in the zone's records
+func getRecordState(record acmTypes.ResourceRecord, zoneRecords []model.Route53Record) string {
+	name := strings.ToLower(strings.TrimSuffix(utils.DerefString(record.Name, ""), "."))
+	value := strings.ToLower(strings.TrimSuffix(utils.DerefString(record.Value, ""), "."))
+	for _, v := range zoneRecords {
+		if string(v.Type) != string(record.Type) || strings.ToLower(strings.TrimSuffix(utils.DerefString(v.Name, ""), ".")) != name {
+			continue
+		}
+		for _, rr := range v.ResourceRecords {
+			if strings.ToLower(strings.TrimSuffix(utils.DerefString(rr.Value, ""), ".")) == value {
+				return acmValidationRecordPresent
+			}
+		}
+		return acmValidationRecordDifferent
+	}
+	return acmValidationRecordMissing
+}
+
+func (a *ACMValidationRecords) Render() {
+	cert, err := a.repo.DescribeCertificate(a.certificateArn)
+	if err != nil {
+		panic(err)
+	}
+	hostedZones, err := a.r53Repo.ListHostedZones()
+	if err != nil {
+		panic(err)
+	}
+
+	// validation records can only be created in public zones
+	var publicZones []model.Route53HostedZone
+	var zoneNames []string
+	for _, v := range hostedZones {
+		if v.Id == nil || v.Name == nil || (v.Config != nil && v.Config.PrivateZone) {
+			continue
+		}
+		publicZones = append(publicZones, v)
+		zoneNames = append(zoneNames, *v.Name)
+	}
+
+	// a wildcard and its base domain share a validation record
+	var records []acmValidationRecord
+	indexes := make(map[string]int)
+	for _, v := range cert.DomainValidationOptions {
+		if v.ValidationMethod != acmTypes.ValidationMethodDns || v.ResourceRecord == nil || v.ResourceRecord.Name == nil {
+			continue
+		}
+		key := strings.ToLower(*v.ResourceRecord.Name)
+		if i, ok := indexes[key]; ok {
+			records[i].domains = append(records[i].domains, utils.DerefString(v.DomainName, ""))
+			continue
+		}
+		indexes[key] = len(records)
+		records = append(records, acmValidationRecord{
+			domains: []string{utils.DerefString(v.DomainName, "")},
+			record:  *v.ResourceRecord,
+			status:  v.ValidationStatus,
+			state:   acmValidationRecordNoZone,
+		})
+	}
+
+	zoneRecords := make(map[string][]model.Route53Record)
+	for i, v := range records {
+		index := utils.FindRoute53HostedZone(*v.record.Name, zoneNames)
+		if index < 0 {
+			continue
+		}
+		zone := publicZones[index]
+		if _, ok := zoneRecords[*zone.Id]; !ok {
+			recordSets, err := a.r53Repo.ListRecords(*zone.Id)
+			if err != nil {
+				panic(err)
+			}
+			zoneRecords[*zone.Id] = recordSets
+		}
+		records[i].zone = &zone
+		records[i].state = getRecordState(v.record, zoneRecords[*zone.Id])
+	}
+	a.records = records
+
+	var data [][]string
+	for _, v := range records {
+		zoneName := "-"
+		if v.zone != nil {
+			zoneName = strings.TrimSuffix(*v.zone.Name, ".")
+		}
+		data = append(data, []string{
+			strings.Join(v.domains, ", "),
+			utils.AutoCase(string(v.status)),
+			utils.DerefString(v.record.Name, ""),
+			string(v.record.Type),
+			utils.DerefString(v.record.Value, ""),
+			zoneName,
+			v.state,
+		})
+	}
+	a.SetData(data)
+}
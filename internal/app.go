@@ -31,10 +31,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"net/http"
 	"strings"
+	"time"
 )
 
 type Application struct {
@@ -45,6 +47,11 @@ type Application struct {
 	components []Component
 	running    bool
 	region     string
+	settings   *settings.Settings
+
+	clipboard           []byte
+	clipboardPending    bool
+	clipboardGeneration int
 }
 
 func NewApplication() *Application {
@@ -101,7 +108,7 @@ func NewApplication() *Application {
 	gaRepo := repo.NewGlobalAccelerator(gaClient)
 	iamRepo := repo.NewIAM(iamClient)
 	kmsRepo := repo.NewKMS(kmsClient)
-	lambdaRepo := repo.NewLambda(lambdaClient)
+	lambdaRepo := repo.NewLambda(lambdaClient, httpClient)
 	mqRepo := repo.NewMQ(mqClient)
 	mskRepo := repo.NewMSK(mskClient)
 	rdsRepo := repo.NewRDS(rdsClient)
@@ -149,8 +156,14 @@ func NewApplication() *Application {
 	flex.SetDirection(tview.FlexRow)
 
 	app.SetRoot(flex, true).SetFocus(pages)
+	app.SetAfterDrawFunc(a.flushClipboard)
 	a.app = app
 	a.pages = pages
+	userSettings, err := settings.Load()
+	if err != nil {
+		userSettings = &settings.Settings{Favorites: []string{}}
+	}
+	a.settings = userSettings
 	a.region = cfg.Region
 
 	header := NewHeader(stsRepo, iamRepo, a)
@@ -167,13 +180,13 @@ func NewApplication() *Application {
 	a.AddAndSwitch(services)
 	app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		if event.Key() == tcell.KeyEscape {
-			a.Close()
+			a.confirmClose(len(a.components)-1, a.Close)
 			return nil
 		}
 
 		// Ctrl+t: Return to top (Services page)
 		if event.Key() == tcell.KeyCtrlT {
-			a.ReturnToTop()
+			a.confirmClose(1, a.ReturnToTop)
 			return nil
 		}
 
@@ -188,6 +201,14 @@ func NewApplication() *Application {
 			return event
 		}
 
+		// typed text belongs to a focused text field, like a filter, rather than a key action
+		if event.Key() == tcell.KeyRune {
+			switch a.app.GetFocus().(type) {
+			case *tview.InputField, *tview.TextArea:
+				return event
+			}
+		}
+
 		actions := a.GetActiveKeyActions()
 		for _, action := range actions {
 			if event.Name() == action.Key.Name() {
@@ -286,6 +307,46 @@ func (a *Application) Close() {
 	}
 }
 
+// confirmClose runs close, first asking for confirmation if any component it would close from index from up has a
+// close warning
+func (a *Application) confirmClose(from int, close func()) {
+	for i := len(a.components) - 1; i >= from && i > 0; i-- {
+		v := a.components[i]
+		if g, ok := v.(CloseGuard); ok {
+			if warning := g.CloseWarning(); warning != "" {
+				a.Confirm(v.GetService(), warning, "Close", close)
+				return
+			}
+		}
+	}
+	close()
+}
+
+// IsOpen reports whether v is still on the page stack, for views that update in the background
+func (a *Application) IsOpen(v Component) bool {
+	for _, c := range a.components {
+		if c == v {
+			return true
+		}
+	}
+	return false
+}
+
+// AutoRefresh re-renders v after each interval for as long as it is open and active returns true
+func (a *Application) AutoRefresh(v Component, interval time.Duration, active func() bool) {
+	time.AfterFunc(interval, func() {
+		a.app.QueueUpdateDraw(func() {
+			if !a.IsOpen(v) {
+				return
+			}
+			v.Render()
+			if active() {
+				a.AutoRefresh(v, interval, active)
+			}
+		})
+	})
+}
+
 func (a *Application) Run() error {
 	a.running = true
 	return a.app.Run()
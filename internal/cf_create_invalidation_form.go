@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const cfRecentPathPlaceholder = "<add a recent path>"
+
+type CFCreateInvalidationForm struct {
+	*tview.Form
+	view.CloudFront
+	repo           *repo.CloudFront
+	distributionId string
+	settings       *settings.Settings
+	app            *Application
+}
+
+func NewCFCreateInvalidationForm(repo *repo.CloudFront, distributionId string, settings *settings.Settings, app *Application) *CFCreateInvalidationForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Create Invalidation ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	c := &CFCreateInvalidationForm{
+		Form:           form,
+		repo:           repo,
+		distributionId: distributionId,
+		settings:       settings,
+		app:            app,
+	}
+
+	recentPaths := append([]string{cfRecentPathPlaceholder}, settings.GetRecentInvalidationPaths(distributionId)...)
+
+	// the selected func is set once all items exist, since setting options triggers it
+	form.AddDropDown("Recent Paths", recentPaths, 0, nil)
+	form.AddTextArea("Paths", "", 0, 10, 0, nil)
+	form.AddTextView("", "One path per line, starting with /. A * wildcard is only allowed at the end of a path.", 0, 2, false, false)
+	form.AddButton("Create", c.createHandler)
+	form.AddButton("Cancel", c.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	form.GetFormItem(0).(*tview.DropDown).SetSelectedFunc(c.recentPathHandler)
+	return c
+}
+
+func (c *CFCreateInvalidationForm) pathsArea() *tview.TextArea {
+	return c.GetFormItem(1).(*tview.TextArea)
+}
+
+// recentPathHandler appends the chosen recent path to the list, then resets the drop down
+func (c *CFCreateInvalidationForm) recentPathHandler(path string, index int) {
+	if index <= 0 {
+		return
+	}
+	text := strings.TrimRight(c.pathsArea().GetText(), "\n")
+	if len(text) > 0 {
+		text += "\n"
+	}
+	c.pathsArea().SetText(text+path, true)
+	c.GetFormItem(0).(*tview.DropDown).SetCurrentOption(0)
+}
+
+func (c *CFCreateInvalidationForm) createHandler() {
+	paths := utils.ParseCloudFrontInvalidationPaths(c.pathsArea().GetText())
+	if len(paths) == 0 {
+		c.app.ShowError(c.GetService(), "At least one path is required")
+		return
+	}
+	for _, v := range paths {
+		if err := utils.ValidateCloudFrontInvalidationPath(v); err != nil {
+			c.app.ShowError(c.GetService(), fmt.Sprintf("Invalid path %v: %v", v, err))
+			return
+		}
+	}
+
+	if _, err := c.repo.CreateInvalidation(c.distributionId, paths); err != nil {
+		c.app.ShowError(c.GetService(), fmt.Sprintf("Create invalidation failed: %v", err))
+		return
+	}
+	// the history is a convenience, so failing to save it shouldn't block the invalidation
+	_ = c.settings.AddRecentInvalidationPaths(c.distributionId, paths)
+
+	c.app.Close()
+	invalidationsView := NewCFDistributionInvalidations(c.repo, c.distributionId, c.app)
+	c.app.AddAndSwitch(invalidationsView)
+}
+
+func (c *CFCreateInvalidationForm) cancelHandler() {
+	c.app.Close()
+}
+
+func (c CFCreateInvalidationForm) GetLabels() []string {
+	return []string{c.distributionId, "Create Invalidation"}
+}
+
+func (c CFCreateInvalidationForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (c CFCreateInvalidationForm) Render() {
+}
@@ -1,6 +1,9 @@
 package internal
 
 import (
+	"time"
+
+	"github.com/bporter816/aws-tui/internal/model"
 	"github.com/bporter816/aws-tui/internal/repo"
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
@@ -14,6 +17,8 @@ type CFDistributionInvalidations struct {
 	repo           *repo.CloudFront
 	distributionId string
 	app            *Application
+	model          []model.CloudFrontInvalidation
+	refreshing     bool
 }
 
 func NewCFDistributionInvalidations(repo *repo.CloudFront, distributionId string, app *Application) *CFDistributionInvalidations {
@@ -53,11 +58,22 @@ func (c CFDistributionInvalidations) GetKeyActions() []KeyAction {
 	}
 }
 
-func (c CFDistributionInvalidations) Render() {
+// isInProgress reports whether any invalidation has yet to reach Completed
+func (c CFDistributionInvalidations) isInProgress() bool {
+	for _, v := range c.model {
+		if utils.DerefString(v.Status, "") != "Completed" {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CFDistributionInvalidations) Render() {
 	model, err := c.repo.ListInvalidations(c.distributionId)
 	if err != nil {
 		panic(err)
 	}
+	c.model = model
 
 	var data [][]string
 	for _, v := range model {
@@ -72,4 +88,12 @@ func (c CFDistributionInvalidations) Render() {
 		})
 	}
 	c.SetData(data)
+
+	if !c.refreshing && c.isInProgress() {
+		c.refreshing = true
+		c.app.AutoRefresh(c, 10*time.Second, func() bool {
+			c.refreshing = c.isInProgress()
+			return c.refreshing
+		})
+	}
 }
@@ -3,6 +3,7 @@ package internal
 import (
 	"github.com/bporter816/aws-tui/internal/model"
 	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
 	"github.com/bporter816/aws-tui/internal/view"
@@ -12,12 +13,13 @@ import (
 type CFDistributions struct {
 	*ui.Table
 	view.CloudFront
-	repo  *repo.CloudFront
-	app   *Application
-	model []model.CloudFrontDistribution
+	repo     *repo.CloudFront
+	app      *Application
+	model    []model.CloudFrontDistribution
+	settings *settings.Settings
 }
 
-func NewCFDistributions(repo *repo.CloudFront, app *Application) *CFDistributions {
+func NewCFDistributions(repo *repo.CloudFront, settings *settings.Settings, app *Application) *CFDistributions {
 	c := &CFDistributions{
 		Table: ui.NewTable([]string{
 			"ID",
@@ -27,8 +29,9 @@ func NewCFDistributions(repo *repo.CloudFront, app *Application) *CFDistribution
 			"ALTERNATE DOMAINS",
 			"DESCRIPTION",
 		}, 1, 0),
-		repo: repo,
-		app:  app,
+		repo:     repo,
+		app:      app,
+		settings: settings,
 	}
 	return c
 }
@@ -73,6 +76,15 @@ func (c CFDistributions) invalidationsHandler() {
 	c.app.AddAndSwitch(invalidationsView)
 }
 
+func (c CFDistributions) createInvalidationHandler() {
+	id, err := c.GetColSelection("ID")
+	if err != nil {
+		return
+	}
+	createInvalidationForm := NewCFCreateInvalidationForm(c.repo, id, c.settings, c.app)
+	c.app.AddAndSwitch(createInvalidationForm)
+}
+
 func (c CFDistributions) tagsHandler() {
 	row, err := c.GetRowSelection()
 	if err != nil {
@@ -106,6 +118,11 @@ func (c CFDistributions) GetKeyActions() []KeyAction {
 			Description: "Invalidations",
 			Action:      c.invalidationsHandler,
 		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'n', tcell.ModNone),
+			Description: "New Invalidation",
+			Action:      c.createInvalidationHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
@@ -1,10 +1,15 @@
 package internal
 
 import (
+	"errors"
+	"fmt"
+
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	"github.com/bporter816/aws-tui/internal/repo"
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
 	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
 )
 
 type CFFunctionCode struct {
@@ -13,6 +18,7 @@ type CFFunctionCode struct {
 	repo  *repo.CloudFront
 	name  string
 	stage string
+	app   *Application
 }
 
 func NewCFFunctionCode(repo *repo.CloudFront, name string, stage string, app *Application) *CFFunctionCode {
@@ -21,6 +27,7 @@ func NewCFFunctionCode(repo *repo.CloudFront, name string, stage string, app *Ap
 		repo:  repo,
 		name:  name,
 		stage: stage,
+		app:   app,
 	}
 	return c
 }
@@ -29,12 +36,69 @@ func (c CFFunctionCode) GetLabels() []string {
 	return []string{c.name + utils.AutoCase(string(c.stage)), "Code"}
 }
 
+// editHandler opens the DEVELOPMENT code in an external editor, since that is the only stage that can be changed
+func (c CFFunctionCode) editHandler() {
+	code, etag, err := c.repo.GetFunctionCode(c.name, "Development")
+	if err != nil {
+		c.app.ShowError(c.GetService(), fmt.Sprintf("Get function code failed: %v", err))
+		return
+	}
+	edited, err := c.app.EditInEditor(code, c.name+"-*.js")
+	if err != nil {
+		c.app.ShowError(c.GetService(), fmt.Sprintf("Edit failed: %v", err))
+		return
+	}
+	if edited == code {
+		return
+	}
+	if err := c.repo.UpdateFunctionCode(c.name, edited, etag); err != nil {
+		var changed *cfTypes.PreconditionFailed
+		if errors.As(err, &changed) {
+			c.app.ShowError(c.GetService(), "The DEVELOPMENT stage of "+c.name+" changed while it was being edited, so the edit was not saved. Edit it again to start from the latest code.")
+			return
+		}
+		c.app.ShowError(c.GetService(), fmt.Sprintf("Update function failed: %v", err))
+		return
+	}
+	if c.stage == "Development" {
+		c.Render()
+	} else {
+		c.app.ShowMessage(c.GetService(), "Updated the DEVELOPMENT stage of "+c.name+". Publish it to make it LIVE.")
+	}
+}
+
+func (c CFFunctionCode) testHandler() {
+	testForm := NewCFFunctionTestForm(c.repo, c.name, c.app)
+	c.app.AddAndSwitch(testForm)
+}
+
+func (c CFFunctionCode) publishHandler() {
+	publishView := NewCFFunctionPublish(c.repo, c.name, c.app)
+	c.app.AddAndSwitch(publishView)
+}
+
 func (c CFFunctionCode) GetKeyActions() []KeyAction {
-	return []KeyAction{}
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'e', tcell.ModNone),
+			Description: "Edit",
+			Action:      c.editHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 't', tcell.ModNone),
+			Description: "Test",
+			Action:      c.testHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'p', tcell.ModNone),
+			Description: "Publish",
+			Action:      c.publishHandler,
+		},
+	}
 }
 
 func (c CFFunctionCode) Render() {
-	code, err := c.repo.GetFunctionCode(c.name, c.stage)
+	code, _, err := c.repo.GetFunctionCode(c.name, c.stage)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+// CFFunctionPublish shows what publishing would change in the LIVE stage of a function before doing it
+type CFFunctionPublish struct {
+	*ui.Text
+	view.CloudFront
+	repo    *repo.CloudFront
+	name    string
+	app     *Application
+	changed bool
+	etag    string // of the DEVELOPMENT code in the diff, so publishing fails if it changed since
+}
+
+func NewCFFunctionPublish(repo *repo.CloudFront, name string, app *Application) *CFFunctionPublish {
+	c := &CFFunctionPublish{
+		Text: ui.NewText(true, "diff"),
+		repo: repo,
+		name: name,
+		app:  app,
+	}
+	return c
+}
+
+func (c CFFunctionPublish) GetLabels() []string {
+	return []string{c.name, "Publish"}
+}
+
+func (c CFFunctionPublish) publishHandler() {
+	if !c.changed {
+		c.app.ShowMessage(c.GetService(), "DEVELOPMENT is the same as LIVE, so there is nothing to publish")
+		return
+	}
+	c.app.Confirm(c.GetService(), "Publish the DEVELOPMENT stage of "+c.name+" to LIVE? Distributions associated with the function will start running it.", "Publish", func() {
+		if err := c.repo.PublishFunction(c.name, c.etag); err != nil {
+			var changed *cfTypes.PreconditionFailed
+			if errors.As(err, &changed) {
+				c.app.ShowError(c.GetService(), "The DEVELOPMENT stage of "+c.name+" changed since the diff was shown, so nothing was published. Refresh to review the new diff.")
+				return
+			}
+			c.app.ShowError(c.GetService(), fmt.Sprintf("Publish failed: %v", err))
+			return
+		}
+		c.app.Close()
+		c.app.ShowMessage(c.GetService(), "Published "+c.name+" to LIVE")
+	})
+}
+
+func (c *CFFunctionPublish) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'p', tcell.ModNone),
+			Description: "Confirm Publish",
+			Action:      c.publishHandler,
+		},
+	}
+}
+
+func (c *CFFunctionPublish) Render() {
+	development, etag, err := c.repo.GetFunctionCode(c.name, "Development")
+	if err != nil {
+		panic(err)
+	}
+	c.etag = etag
+	// a function that has never been published has no LIVE stage
+	live, _, err := c.repo.GetFunctionCode(c.name, "Live")
+	var notFound *cfTypes.NoSuchFunctionExists
+	if err != nil && !errors.As(err, &notFound) {
+		panic(err)
+	}
+
+	diff := utils.UnifiedDiff(live, development, "LIVE", "DEVELOPMENT")
+	c.changed = diff != ""
+	if !c.changed {
+		diff = "No changes between LIVE and DEVELOPMENT"
+	}
+	c.SetText(diff)
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var cfFunctionEventTypes = []string{"viewer-request", "viewer-response"}
+
+// cfFunctionTestEvents are minimal events in the format CloudFront passes to functions
+var cfFunctionTestEvents = map[string]string{
+	"viewer-request": `{
+  "version": "1.0",
+  "context": {
+    "eventType": "viewer-request"
+  },
+  "viewer": {
+    "ip": "198.51.100.11"
+  },
+  "request": {
+    "method": "GET",
+    "uri": "/index.html",
+    "querystring": {},
+    "headers": {
+      "host": {
+        "value": "www.example.com"
+      }
+    },
+    "cookies": {}
+  }
+}`,
+	"viewer-response": `{
+  "version": "1.0",
+  "context": {
+    "eventType": "viewer-response"
+  },
+  "viewer": {
+    "ip": "198.51.100.11"
+  },
+  "request": {
+    "method": "GET",
+    "uri": "/index.html",
+    "querystring": {},
+    "headers": {
+      "host": {
+        "value": "www.example.com"
+      }
+    },
+    "cookies": {}
+  },
+  "response": {
+    "statusCode": 200,
+    "statusDescription": "OK",
+    "headers": {
+      "content-type": {
+        "value": "text/html"
+      }
+    },
+    "cookies": {}
+  }
+}`,
+}
+
+type CFFunctionTestForm struct {
+	*tview.Form
+	view.CloudFront
+	repo *repo.CloudFront
+	name string
+	app  *Application
+}
+
+func NewCFFunctionTestForm(repo *repo.CloudFront, name string, app *Application) *CFFunctionTestForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Test " + name + " ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	c := &CFFunctionTestForm{
+		Form: form,
+		repo: repo,
+		name: name,
+		app:  app,
+	}
+
+	// the selected func is set once all items exist, since setting options triggers it
+	form.AddDropDown("Event Type", cfFunctionEventTypes, -1, nil)
+	form.AddTextArea("Event", "", 0, 20, 0, nil)
+	form.AddButton("Test", c.testHandler)
+	form.AddButton("Cancel", c.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	dropDown := form.GetFormItem(0).(*tview.DropDown)
+	dropDown.SetSelectedFunc(c.eventTypeHandler)
+	dropDown.SetCurrentOption(0)
+	return c
+}
+
+func (c *CFFunctionTestForm) eventTypeHandler(eventType string, index int) {
+	if event, ok := cfFunctionTestEvents[eventType]; ok {
+		c.GetFormItem(1).(*tview.TextArea).SetText(event, false)
+	}
+}
+
+func (c *CFFunctionTestForm) testHandler() {
+	event := strings.TrimSpace(c.GetFormItem(1).(*tview.TextArea).GetText())
+	if !json.Valid([]byte(event)) {
+		c.app.ShowError(c.GetService(), "Event is not valid JSON")
+		return
+	}
+
+	result, err := c.repo.TestFunction(c.name, event)
+	if err != nil {
+		c.app.ShowError(c.GetService(), fmt.Sprintf("Test failed: %v", err))
+		return
+	}
+
+	// keep the form open underneath so the event can be tweaked and run again
+	resultView := NewCFFunctionTestResult(c.name, result, c.app)
+	c.app.AddAndSwitch(resultView)
+}
+
+func (c *CFFunctionTestForm) cancelHandler() {
+	c.app.Close()
+}
+
+func (c CFFunctionTestForm) GetLabels() []string {
+	return []string{c.name, "Test"}
+}
+
+func (c CFFunctionTestForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (c CFFunctionTestForm) Render() {
+}
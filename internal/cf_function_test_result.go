@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type CFFunctionTestResult struct {
+	*ui.Text
+	view.CloudFront
+	name   string
+	result model.CloudFrontFunctionTestResult
+	app    *Application
+}
+
+func NewCFFunctionTestResult(name string, result model.CloudFrontFunctionTestResult, app *Application) *CFFunctionTestResult {
+	c := &CFFunctionTestResult{
+		Text:   ui.NewText(false, ""),
+		name:   name,
+		result: result,
+		app:    app,
+	}
+	return c
+}
+
+func (c CFFunctionTestResult) GetLabels() []string {
+	return []string{c.name, "Test Result"}
+}
+
+func (c CFFunctionTestResult) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (c CFFunctionTestResult) Render() {
+	var sb strings.Builder
+	sb.WriteString("Compute Utilization: " + utils.DerefString(c.result.ComputeUtilization, "-") + "\n")
+	if c.result.FunctionErrorMessage != nil && *c.result.FunctionErrorMessage != "" {
+		sb.WriteString("Error: " + *c.result.FunctionErrorMessage + "\n")
+	}
+
+	sb.WriteString("\nLogs:\n")
+	if len(c.result.FunctionExecutionLogs) == 0 {
+		sb.WriteString("  <none>\n")
+	}
+	for _, v := range c.result.FunctionExecutionLogs {
+		sb.WriteString("  " + v + "\n")
+	}
+
+	sb.WriteString("\nResulting Event:\n")
+	output := utils.DerefString(c.result.FunctionOutput, "")
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(output), "", "  "); err == nil {
+		output = buf.String()
+	}
+	sb.WriteString(output + "\n")
+
+	c.SetText(sb.String())
+}
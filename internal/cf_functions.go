@@ -48,6 +48,24 @@ func (c CFFunctions) codeHandler() {
 	c.app.AddAndSwitch(codeView)
 }
 
+func (c CFFunctions) testHandler() {
+	name, err := c.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	testForm := NewCFFunctionTestForm(c.repo, name, c.app)
+	c.app.AddAndSwitch(testForm)
+}
+
+func (c CFFunctions) publishHandler() {
+	name, err := c.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	publishView := NewCFFunctionPublish(c.repo, name, c.app)
+	c.app.AddAndSwitch(publishView)
+}
+
 func (c CFFunctions) GetKeyActions() []KeyAction {
 	return []KeyAction{
 		{
@@ -55,6 +73,16 @@ func (c CFFunctions) GetKeyActions() []KeyAction {
 			Description: "View Code",
 			Action:      c.codeHandler,
 		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 't', tcell.ModNone),
+			Description: "Test",
+			Action:      c.testHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'p', tcell.ModNone),
+			Description: "Publish",
+			Action:      c.publishHandler,
+		},
 	}
 }
 
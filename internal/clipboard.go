@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// setClipboard queues data for the clipboard. tcell writes it with OSC 52 on the next draw, so it doesn't interleave
+// with screen updates. Empty data clears the clipboard. It returns a generation that changes with every copy.
+func (a *Application) setClipboard(data []byte) int {
+	a.clipboard = data
+	a.clipboardPending = true
+	a.clipboardGeneration++
+	return a.clipboardGeneration
+}
+
+// flushClipboard is the after draw hook that hands any queued clipboard data to the screen
+func (a *Application) flushClipboard(screen tcell.Screen) {
+	if !a.clipboardPending {
+		return
+	}
+	screen.SetClipboard(a.clipboard)
+	a.clipboard, a.clipboardPending = nil, false
+}
+
+// CopyToClipboard sets the clipboard with OSC 52, clearing it afterwards if clipboard_clear_seconds is set
+func (a *Application) CopyToClipboard(service string, value string) {
+	generation := a.setClipboard([]byte(value))
+	message := "Copied to clipboard"
+	if seconds := a.settings.ClipboardClearSeconds; seconds > 0 {
+		time.AfterFunc(time.Duration(seconds)*time.Second, func() {
+			a.app.QueueUpdateDraw(func() {
+				// a later copy replaced the value, and will clear it itself
+				if a.clipboardGeneration == generation {
+					a.setClipboard([]byte{})
+				}
+			})
+		})
+		message = fmt.Sprintf("%v, clearing in %v seconds", message, seconds)
+	}
+	a.ShowMessage(service, message)
+}
@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type CloudWatchLogEvents struct {
+	*ui.Text
+	view.CloudWatch
+	repo          *repo.CloudWatch
+	app           *Application
+	logGroupName  string
+	logStreamName string
+}
+
+func NewCloudWatchLogEvents(repo *repo.CloudWatch, logGroupName string, logStreamName string, app *Application) *CloudWatchLogEvents {
+	c := &CloudWatchLogEvents{
+		Text:          ui.NewText(false, ""),
+		repo:          repo,
+		app:           app,
+		logGroupName:  logGroupName,
+		logStreamName: logStreamName,
+	}
+	return c
+}
+
+func (c CloudWatchLogEvents) GetLabels() []string {
+	return []string{c.logGroupName, c.logStreamName, "Events"}
+}
+
+func (c CloudWatchLogEvents) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (c CloudWatchLogEvents) Render() {
+	model, err := c.repo.ListLogEvents(c.logGroupName, c.logStreamName)
+	if err != nil {
+		panic(err)
+	}
+
+	var b strings.Builder
+	for _, v := range model {
+		if v.Timestamp != nil {
+			b.WriteString(time.UnixMilli(*v.Timestamp).Format(utils.DefaultTimeFormat))
+			b.WriteString("  ")
+		}
+		b.WriteString(strings.TrimRight(utils.DerefString(v.Message, ""), "\n"))
+		b.WriteString("\n")
+	}
+	c.SetText(b.String())
+	c.ScrollToEnd()
+}
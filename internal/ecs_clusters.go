@@ -13,12 +13,13 @@ import (
 type ECSClusters struct {
 	*ui.Table
 	view.ECS
-	repo  *repo.ECS
-	app   *Application
-	model []model.ECSCluster
+	repo   *repo.ECS
+	cwRepo *repo.CloudWatch
+	app    *Application
+	model  []model.ECSCluster
 }
 
-func NewECSClusters(repo *repo.ECS, app *Application) *ECSClusters {
+func NewECSClusters(repo *repo.ECS, cwRepo *repo.CloudWatch, app *Application) *ECSClusters {
 	e := &ECSClusters{
 		Table: ui.NewTable([]string{
 			"NAME",
@@ -27,8 +28,9 @@ func NewECSClusters(repo *repo.ECS, app *Application) *ECSClusters {
 			"TASKS (P/R)",
 			"CONTAINER INSTANCES",
 		}, 1, 0),
-		repo: repo,
-		app:  app,
+		repo:   repo,
+		cwRepo: cwRepo,
+		app:    app,
 	}
 	return e
 }
@@ -42,7 +44,7 @@ func (e ECSClusters) servicesHandler() {
 	if err != nil {
 		return
 	}
-	servicesView := NewECSServices(name, e.repo, e.app)
+	servicesView := NewECSServices(name, e.repo, e.cwRepo, e.app)
 	e.app.AddAndSwitch(servicesView)
 }
 
@@ -51,7 +53,7 @@ func (e ECSClusters) tasksHandler() {
 	if err != nil {
 		return
 	}
-	tasksView := NewECSTasks(name, "", e.repo, e.app)
+	tasksView := NewECSTasks(name, "", e.repo, e.cwRepo, e.app)
 	e.app.AddAndSwitch(tasksView)
 }
 
@@ -16,12 +16,13 @@ type ECSServices struct {
 	*ui.Table
 	view.ECS
 	repo        *repo.ECS
+	cwRepo      *repo.CloudWatch
 	app         *Application
 	model       []model.ECSService
 	clusterName string
 }
 
-func NewECSServices(clusterName string, repo *repo.ECS, app *Application) *ECSServices {
+func NewECSServices(clusterName string, repo *repo.ECS, cwRepo *repo.CloudWatch, app *Application) *ECSServices {
 	e := &ECSServices{
 		Table: ui.NewTable([]string{
 			"NAME",
@@ -32,6 +33,7 @@ func NewECSServices(clusterName string, repo *repo.ECS, app *Application) *ECSSe
 		}, 1, 0),
 		clusterName: clusterName,
 		repo:        repo,
+		cwRepo:      cwRepo,
 		app:         app,
 	}
 	return e
@@ -46,7 +48,7 @@ func (e ECSServices) tasksHandler() {
 	if err != nil {
 		return
 	}
-	tasksView := NewECSTasks(e.clusterName, serviceName, e.repo, e.app)
+	tasksView := NewECSTasks(e.clusterName, serviceName, e.repo, e.cwRepo, e.app)
 	e.app.AddAndSwitch(tasksView)
 }
 
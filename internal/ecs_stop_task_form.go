@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type ECSStopTaskForm struct {
+	*tview.Form
+	view.ECS
+	repo        *repo.ECS
+	clusterName string
+	taskArn     string
+	taskId      string
+	app         *Application
+	onComplete  func()
+}
+
+func NewECSStopTaskForm(repo *repo.ECS, clusterName, taskArn, taskId string, app *Application, onComplete func()) *ECSStopTaskForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Stop Task ")
+	form.SetTitleColor(tcell.ColorRed)
+
+	e := &ECSStopTaskForm{
+		Form:        form,
+		repo:        repo,
+		clusterName: clusterName,
+		taskArn:     taskArn,
+		taskId:      taskId,
+		app:         app,
+		onComplete:  onComplete,
+	}
+
+	form.AddTextView("Task", taskId, 0, 1, false, false)
+	form.AddInputField("Reason", "Stopped from aws-tui", 0, nil, nil)
+	form.AddButton("Stop", e.stopHandler)
+	form.AddButton("Cancel", e.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorRed)
+	form.SetButtonTextColor(tcell.ColorWhite)
+
+	return e
+}
+
+func (e *ECSStopTaskForm) stopHandler() {
+	reason := e.GetFormItem(1).(*tview.InputField).GetText()
+	if err := e.repo.StopTask(e.clusterName, e.taskArn, reason); err != nil {
+		e.app.ShowError(e.GetService(), fmt.Sprintf("Stop task failed: %v", err))
+		return
+	}
+
+	e.app.Close()
+	if e.onComplete != nil {
+		e.onComplete()
+	}
+}
+
+func (e *ECSStopTaskForm) cancelHandler() {
+	e.app.Close()
+}
+
+func (e ECSStopTaskForm) GetLabels() []string {
+	return []string{e.taskId, "Stop"}
+}
+
+func (e ECSStopTaskForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (e ECSStopTaskForm) Render() {
+}
@@ -0,0 +1,131 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type ECSTaskContainers struct {
+	*ui.Table
+	view.ECS
+	repo        *repo.ECS
+	cwRepo      *repo.CloudWatch
+	app         *Application
+	model       []model.ECSContainer
+	clusterName string
+	taskArn     string
+	taskDefArn  string
+}
+
+func NewECSTaskContainers(clusterName string, taskArn string, repo *repo.ECS, cwRepo *repo.CloudWatch, app *Application) *ECSTaskContainers {
+	e := &ECSTaskContainers{
+		Table: ui.NewTable([]string{
+			"NAME",
+			"IMAGE",
+			"IMAGE DIGEST",
+			"LAST STATUS",
+			"EXIT CODE",
+			"REASON",
+			"HEALTH",
+		}, 1, 0),
+		clusterName: clusterName,
+		taskArn:     taskArn,
+		repo:        repo,
+		cwRepo:      cwRepo,
+		app:         app,
+	}
+	return e
+}
+
+func (e ECSTaskContainers) taskId() string {
+	return e.taskArn[strings.LastIndex(e.taskArn, "/")+1:]
+}
+
+func (e ECSTaskContainers) GetLabels() []string {
+	return []string{e.taskId(), "Containers"}
+}
+
+func (e ECSTaskContainers) logsHandler() {
+	name, err := e.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	taskDefinition, err := e.repo.GetTaskDefinition(e.taskDefArn)
+	if err != nil {
+		e.app.ShowError(e.GetService(), fmt.Sprintf("Failed to get task definition: %v", err))
+		return
+	}
+	for _, v := range taskDefinition.ContainerDefinitions {
+		if utils.DerefString(v.Name, "") != name {
+			continue
+		}
+		group, stream, region, err := utils.GetECSAwsLogsLocation(v, e.taskId())
+		if err != nil {
+			e.app.ShowError(e.GetService(), err.Error())
+			return
+		}
+		if len(region) > 0 && region != e.app.region {
+			e.app.ShowError(e.GetService(), fmt.Sprintf("Logs are in %v, but the current region is %v", region, e.app.region))
+			return
+		}
+		logsView := NewCloudWatchLogEvents(e.cwRepo, group, stream, e.app)
+		e.app.AddAndSwitch(logsView)
+		return
+	}
+	e.app.ShowError(e.GetService(), "Container not found in task definition")
+}
+
+func (e ECSTaskContainers) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'l', tcell.ModNone),
+			Description: "Logs",
+			Action:      e.logsHandler,
+		},
+	}
+}
+
+func (e *ECSTaskContainers) Render() {
+	task, err := e.repo.GetTask(e.clusterName, e.taskArn)
+	if err != nil {
+		panic(err)
+	}
+	e.taskDefArn = utils.DerefString(task.TaskDefinitionArn, "")
+
+	var data [][]string
+	e.model = nil
+	for _, v := range task.Containers {
+		e.model = append(e.model, model.ECSContainer(v))
+		var lastStatus, exitCode, health string
+		if v.LastStatus != nil {
+			lastStatus = utils.AutoCase(*v.LastStatus)
+		}
+		if v.ExitCode != nil {
+			exitCode = strconv.Itoa(int(*v.ExitCode))
+		} else {
+			exitCode = "-"
+		}
+		health = utils.AutoCase(string(v.HealthStatus))
+		if len(health) == 0 {
+			health = "-"
+		}
+		data = append(data, []string{
+			utils.DerefString(v.Name, ""),
+			utils.DerefString(v.Image, ""),
+			utils.DerefString(v.ImageDigest, "-"),
+			lastStatus,
+			exitCode,
+			utils.DerefString(v.Reason, "-"),
+			health,
+		})
+	}
+	e.SetData(data)
+}
@@ -14,13 +14,14 @@ type ECSTasks struct {
 	*ui.Table
 	view.ECS
 	repo        *repo.ECS
+	cwRepo      *repo.CloudWatch
 	app         *Application
 	model       []model.ECSTask
 	clusterName string
 	serviceName string
 }
 
-func NewECSTasks(clusterName string, serviceName string, repo *repo.ECS, app *Application) *ECSTasks {
+func NewECSTasks(clusterName string, serviceName string, repo *repo.ECS, cwRepo *repo.CloudWatch, app *Application) *ECSTasks {
 	e := &ECSTasks{
 		Table: ui.NewTable([]string{
 			"ID",
@@ -35,6 +36,7 @@ func NewECSTasks(clusterName string, serviceName string, repo *repo.ECS, app *Ap
 		clusterName: clusterName,
 		serviceName: serviceName,
 		repo:        repo,
+		cwRepo:      cwRepo,
 		app:         app,
 	}
 	return e
@@ -47,6 +49,34 @@ func (e ECSTasks) GetLabels() []string {
 	return []string{e.serviceName, "Tasks"}
 }
 
+func (e ECSTasks) containersHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if a := e.model[row-1].TaskArn; a != nil {
+		containersView := NewECSTaskContainers(e.clusterName, *a, e.repo, e.cwRepo, e.app)
+		e.app.AddAndSwitch(containersView)
+	}
+}
+
+func (e *ECSTasks) stopHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if a := e.model[row-1].TaskArn; a != nil {
+		id, err := e.GetColSelection("ID")
+		if err != nil {
+			return
+		}
+		stopForm := NewECSStopTaskForm(e.repo, e.clusterName, *a, id, e.app, func() {
+			e.Render()
+		})
+		e.app.AddAndSwitch(stopForm)
+	}
+}
+
 func (e ECSTasks) tagsHandler() {
 	row, err := e.GetRowSelection()
 	if err != nil {
@@ -58,8 +88,18 @@ func (e ECSTasks) tagsHandler() {
 	}
 }
 
-func (e ECSTasks) GetKeyActions() []KeyAction {
+func (e *ECSTasks) GetKeyActions() []KeyAction {
 	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'c', tcell.ModNone),
+			Description: "Containers",
+			Action:      e.containersHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone),
+			Description: "Stop Task",
+			Action:      e.stopHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
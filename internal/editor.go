@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+func getEditorCommand() []string {
+	for _, v := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(v)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{"vi"}
+}
+
+// EditInEditor suspends the UI to edit content in $VISUAL or $EDITOR, falling back to vi, and returns the
+// saved content. pattern names the temp file as in os.CreateTemp, so the editor can pick a file type.
+func (a *Application) EditInEditor(content string, pattern string) (string, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	editor := getEditorCommand()
+	var runErr error
+	suspended := a.app.Suspend(func() {
+		cmd := exec.Command(editor[0], append(editor[1:], f.Name())...)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		runErr = cmd.Run()
+	})
+	if !suspended {
+		return "", errors.New("could not suspend the application to run the editor")
+	}
+	if runErr != nil {
+		return "", runErr
+	}
+
+	b, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
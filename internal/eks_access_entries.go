@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type EKSAccessEntries struct {
+	*ui.Table
+	view.EKS
+	repo        *repo.EKS
+	app         *Application
+	model       []model.EKSAccessEntry
+	clusterName string
+}
+
+func NewEKSAccessEntries(repo *repo.EKS, clusterName string, app *Application) *EKSAccessEntries {
+	e := &EKSAccessEntries{
+		Table: ui.NewTable([]string{
+			"PRINCIPAL",
+			"TYPE",
+			"USERNAME",
+			"GROUPS",
+			"CREATED",
+		}, 1, 0),
+		repo:        repo,
+		app:         app,
+		clusterName: clusterName,
+	}
+	return e
+}
+
+func (e EKSAccessEntries) GetLabels() []string {
+	return []string{e.clusterName, "Access Entries"}
+}
+
+func (e EKSAccessEntries) tagsHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if arn := e.model[row-1].AccessEntryArn; arn != nil {
+		tagsView := NewTags(e.repo, e.GetService(), *arn, e.app)
+		e.app.AddAndSwitch(tagsView)
+	}
+}
+
+func (e EKSAccessEntries) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
+			Description: "Tags",
+			Action:      e.tagsHandler,
+		},
+	}
+}
+
+func (e *EKSAccessEntries) Render() {
+	model, err := e.repo.ListAccessEntries(e.clusterName)
+	if err != nil {
+		panic(err)
+	}
+	e.model = model
+
+	var data [][]string
+	for _, v := range model {
+		var created string
+		if v.CreatedAt != nil {
+			created = v.CreatedAt.Format(utils.DefaultTimeFormat)
+		}
+		groups := "-"
+		if len(v.KubernetesGroups) > 0 {
+			groups = strings.Join(v.KubernetesGroups, ", ")
+		}
+		data = append(data, []string{
+			utils.DerefString(v.PrincipalArn, ""),
+			utils.AutoCase(utils.DerefString(v.Type, "")),
+			utils.DerefString(v.Username, "-"),
+			groups,
+			created,
+		})
+	}
+	e.SetData(data)
+}
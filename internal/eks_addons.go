@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type EKSAddons struct {
+	*ui.Table
+	view.EKS
+	repo              *repo.EKS
+	app               *Application
+	model             []model.EKSAddon
+	clusterName       string
+	kubernetesVersion string
+}
+
+func NewEKSAddons(repo *repo.EKS, clusterName string, kubernetesVersion string, app *Application) *EKSAddons {
+	e := &EKSAddons{
+		Table: ui.NewTable([]string{
+			"NAME",
+			"VERSION",
+			"STATUS",
+			"UPDATE AVAILABLE",
+			"SERVICE ACCOUNT ROLE",
+			"HEALTH ISSUES",
+		}, 1, 0),
+		repo:              repo,
+		app:               app,
+		clusterName:       clusterName,
+		kubernetesVersion: kubernetesVersion,
+	}
+	return e
+}
+
+func (e EKSAddons) GetLabels() []string {
+	return []string{e.clusterName, "Add-ons"}
+}
+
+func (e EKSAddons) tagsHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if arn := e.model[row-1].AddonArn; arn != nil {
+		tagsView := NewTags(e.repo, e.GetService(), *arn, e.app)
+		e.app.AddAndSwitch(tagsView)
+	}
+}
+
+func (e EKSAddons) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
+			Description: "Tags",
+			Action:      e.tagsHandler,
+		},
+	}
+}
+
+func (e *EKSAddons) Render() {
+	model, err := e.repo.ListAddons(e.clusterName, e.kubernetesVersion)
+	if err != nil {
+		panic(err)
+	}
+	e.model = model
+
+	var data [][]string
+	for _, v := range model {
+		version := utils.DerefString(v.AddonVersion, "")
+		updateAvailable := "No"
+		if latest := utils.LatestEKSAddonVersion(append(v.AvailableVersions, version)); latest != version {
+			updateAvailable = "Yes (" + latest + ")"
+		} else if len(v.AvailableVersions) == 0 {
+			updateAvailable = "-"
+		}
+		role := "-"
+		if v.ServiceAccountRoleArn != nil {
+			if a, err := arn.Parse(*v.ServiceAccountRoleArn); err == nil {
+				role = utils.GetResourceNameFromArn(a)
+			}
+		}
+		healthIssues := 0
+		if v.Health != nil {
+			healthIssues = len(v.Health.Issues)
+		}
+		data = append(data, []string{
+			utils.DerefString(v.AddonName, ""),
+			version,
+			utils.AutoCase(string(v.Status)),
+			updateAvailable,
+			role,
+			strconv.Itoa(healthIssues),
+		})
+	}
+	e.SetData(data)
+}
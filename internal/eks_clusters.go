@@ -1,6 +1,10 @@
 package internal
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+
 	"github.com/bporter816/aws-tui/internal/model"
 	"github.com/bporter816/aws-tui/internal/repo"
 	"github.com/bporter816/aws-tui/internal/ui"
@@ -47,8 +51,141 @@ func (e EKSClusters) tagsHandler() {
 	}
 }
 
+func (e EKSClusters) nodegroupsHandler() {
+	name, err := e.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	nodegroupsView := NewEKSNodegroups(e.repo, name, e.app)
+	e.app.AddAndSwitch(nodegroupsView)
+}
+
+func (e EKSClusters) fargateProfilesHandler() {
+	name, err := e.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	fargateProfilesView := NewEKSFargateProfiles(e.repo, name, e.app)
+	e.app.AddAndSwitch(fargateProfilesView)
+}
+
+func (e EKSClusters) addonsHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	cluster := e.model[row-1]
+	addonsView := NewEKSAddons(e.repo, utils.DerefString(cluster.Name, ""), utils.DerefString(cluster.Version, ""), e.app)
+	e.app.AddAndSwitch(addonsView)
+}
+
+func (e EKSClusters) identityProviderConfigsHandler() {
+	name, err := e.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	identityProviderConfigsView := NewEKSIdentityProviderConfigs(e.repo, name, e.app)
+	e.app.AddAndSwitch(identityProviderConfigsView)
+}
+
+func (e EKSClusters) accessEntriesHandler() {
+	name, err := e.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	accessEntriesView := NewEKSAccessEntries(e.repo, name, e.app)
+	e.app.AddAndSwitch(accessEntriesView)
+}
+
+// getKubeconfigPath follows kubectl in using the first entry of $KUBECONFIG, falling back to ~/.kube/config
+func getKubeconfigPath() (string, error) {
+	if paths := filepath.SplitList(os.Getenv("KUBECONFIG")); len(paths) > 0 && paths[0] != "" {
+		return paths[0], nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".kube", "config"), nil
+}
+
+func (e EKSClusters) updateKubeconfig(cluster model.EKSCluster, path string) error {
+	if cluster.Arn == nil || cluster.Name == nil || cluster.Endpoint == nil || cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
+		return errors.New("cluster is missing endpoint or certificate authority data")
+	}
+	existing, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	config, err := utils.MergeEKSKubeconfig(existing, utils.EKSKubeconfigEntry{
+		ClusterArn:               *cluster.Arn,
+		ClusterName:              *cluster.Name,
+		Endpoint:                 *cluster.Endpoint,
+		CertificateAuthorityData: *cluster.CertificateAuthority.Data,
+		Region:                   e.app.region,
+	})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, config, 0600)
+}
+
+func (e EKSClusters) updateKubeconfigHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	cluster := e.model[row-1]
+	path, err := getKubeconfigPath()
+	if err != nil {
+		e.app.ShowError(e.GetService(), err.Error())
+		return
+	}
+	name := utils.DerefString(cluster.Name, "")
+	e.app.Confirm(e.GetService(), "Add context for cluster "+name+" to "+path+" and make it the current context?", "Update", func() {
+		if err := e.updateKubeconfig(cluster, path); err != nil {
+			e.app.ShowError(e.GetService(), err.Error())
+			return
+		}
+		e.app.ShowMessage(e.GetService(), "Updated context "+utils.DerefString(cluster.Arn, "")+" in "+path)
+	})
+}
+
 func (e EKSClusters) GetKeyActions() []KeyAction {
 	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'n', tcell.ModNone),
+			Description: "Node Groups",
+			Action:      e.nodegroupsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'f', tcell.ModNone),
+			Description: "Fargate Profiles",
+			Action:      e.fargateProfilesHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone),
+			Description: "Add-ons",
+			Action:      e.addonsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'i', tcell.ModNone),
+			Description: "Identity Providers",
+			Action:      e.identityProviderConfigsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'e', tcell.ModNone),
+			Description: "Access Entries",
+			Action:      e.accessEntriesHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'k', tcell.ModNone),
+			Description: "Update Kubeconfig",
+			Action:      e.updateKubeconfigHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
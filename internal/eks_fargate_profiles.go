@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type EKSFargateProfiles struct {
+	*ui.Table
+	view.EKS
+	repo        *repo.EKS
+	app         *Application
+	model       []model.EKSFargateProfile
+	clusterName string
+}
+
+func NewEKSFargateProfiles(repo *repo.EKS, clusterName string, app *Application) *EKSFargateProfiles {
+	e := &EKSFargateProfiles{
+		Table: ui.NewTable([]string{
+			"NAME",
+			"STATUS",
+			"POD EXECUTION ROLE",
+			"SELECTORS",
+			"SUBNETS",
+			"CREATED",
+		}, 1, 0),
+		repo:        repo,
+		app:         app,
+		clusterName: clusterName,
+	}
+	return e
+}
+
+func (e EKSFargateProfiles) GetLabels() []string {
+	return []string{e.clusterName, "Fargate Profiles"}
+}
+
+func (e EKSFargateProfiles) tagsHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if arn := e.model[row-1].FargateProfileArn; arn != nil {
+		tagsView := NewTags(e.repo, e.GetService(), *arn, e.app)
+		e.app.AddAndSwitch(tagsView)
+	}
+}
+
+func (e EKSFargateProfiles) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
+			Description: "Tags",
+			Action:      e.tagsHandler,
+		},
+	}
+}
+
+func (e *EKSFargateProfiles) Render() {
+	model, err := e.repo.ListFargateProfiles(e.clusterName)
+	if err != nil {
+		panic(err)
+	}
+	e.model = model
+
+	var data [][]string
+	for _, v := range model {
+		var role, created string
+		if v.PodExecutionRoleArn != nil {
+			if a, err := arn.Parse(*v.PodExecutionRoleArn); err == nil {
+				role = utils.GetResourceNameFromArn(a)
+			}
+		}
+		var selectors []string
+		for _, s := range v.Selectors {
+			selector := utils.DerefString(s.Namespace, "*")
+			var labels []string
+			for k, l := range s.Labels {
+				labels = append(labels, k+"="+l)
+			}
+			sort.Strings(labels)
+			if len(labels) > 0 {
+				selector += " (" + strings.Join(labels, ", ") + ")"
+			}
+			selectors = append(selectors, selector)
+		}
+		if v.CreatedAt != nil {
+			created = v.CreatedAt.Format(utils.DefaultTimeFormat)
+		}
+		data = append(data, []string{
+			utils.DerefString(v.FargateProfileName, ""),
+			utils.AutoCase(string(v.Status)),
+			role,
+			strings.Join(selectors, ", "),
+			utils.TruncateStrings(v.Subnets, 2),
+			created,
+		})
+	}
+	e.SetData(data)
+}
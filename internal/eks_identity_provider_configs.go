@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type EKSIdentityProviderConfigs struct {
+	*ui.Table
+	view.EKS
+	repo        *repo.EKS
+	app         *Application
+	model       []model.EKSIdentityProviderConfig
+	clusterName string
+}
+
+func NewEKSIdentityProviderConfigs(repo *repo.EKS, clusterName string, app *Application) *EKSIdentityProviderConfigs {
+	e := &EKSIdentityProviderConfigs{
+		Table: ui.NewTable([]string{
+			"NAME",
+			"STATUS",
+			"ISSUER URL",
+			"CLIENT ID",
+			"USERNAME CLAIM",
+			"GROUPS CLAIM",
+		}, 1, 0),
+		repo:        repo,
+		app:         app,
+		clusterName: clusterName,
+	}
+	return e
+}
+
+func (e EKSIdentityProviderConfigs) GetLabels() []string {
+	return []string{e.clusterName, "Identity Providers"}
+}
+
+func (e EKSIdentityProviderConfigs) tagsHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if arn := e.model[row-1].IdentityProviderConfigArn; arn != nil {
+		tagsView := NewTags(e.repo, e.GetService(), *arn, e.app)
+		e.app.AddAndSwitch(tagsView)
+	}
+}
+
+func (e EKSIdentityProviderConfigs) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
+			Description: "Tags",
+			Action:      e.tagsHandler,
+		},
+	}
+}
+
+func (e *EKSIdentityProviderConfigs) Render() {
+	model, err := e.repo.ListIdentityProviderConfigs(e.clusterName)
+	if err != nil {
+		panic(err)
+	}
+	e.model = model
+
+	var data [][]string
+	for _, v := range model {
+		data = append(data, []string{
+			utils.DerefString(v.IdentityProviderConfigName, ""),
+			utils.AutoCase(string(v.Status)),
+			utils.DerefString(v.IssuerUrl, ""),
+			utils.DerefString(v.ClientId, ""),
+			utils.DerefString(v.UsernameClaim, "-"),
+			utils.DerefString(v.GroupsClaim, "-"),
+		})
+	}
+	e.SetData(data)
+}
@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type EKSNodegroupHealthIssues struct {
+	*ui.Table
+	view.EKS
+	repo          *repo.EKS
+	app           *Application
+	clusterName   string
+	nodegroupName string
+}
+
+func NewEKSNodegroupHealthIssues(repo *repo.EKS, clusterName string, nodegroupName string, app *Application) *EKSNodegroupHealthIssues {
+	e := &EKSNodegroupHealthIssues{
+		Table: ui.NewTable([]string{
+			"CODE",
+			"MESSAGE",
+			"RESOURCES",
+		}, 1, 0),
+		repo:          repo,
+		app:           app,
+		clusterName:   clusterName,
+		nodegroupName: nodegroupName,
+	}
+	return e
+}
+
+func (e EKSNodegroupHealthIssues) GetLabels() []string {
+	return []string{e.nodegroupName, "Health Issues"}
+}
+
+func (e EKSNodegroupHealthIssues) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (e EKSNodegroupHealthIssues) Render() {
+	model, err := e.repo.GetNodegroup(e.clusterName, e.nodegroupName)
+	if err != nil {
+		panic(err)
+	}
+
+	var data [][]string
+	if model.Health != nil {
+		for _, v := range model.Health.Issues {
+			data = append(data, []string{
+				string(v.Code),
+				utils.DerefString(v.Message, ""),
+				strings.Join(v.ResourceIds, ", "),
+			})
+		}
+	}
+	e.SetData(data)
+}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type EKSNodegroups struct {
+	*ui.Table
+	view.EKS
+	repo        *repo.EKS
+	app         *Application
+	model       []model.EKSNodegroup
+	clusterName string
+}
+
+func NewEKSNodegroups(repo *repo.EKS, clusterName string, app *Application) *EKSNodegroups {
+	e := &EKSNodegroups{
+		Table: ui.NewTable([]string{
+			"NAME",
+			"STATUS",
+			"CAPACITY",
+			"INSTANCE TYPES",
+			"SIZE (MIN/DES/MAX)",
+			"AMI TYPE",
+			"RELEASE VERSION",
+			"HEALTH ISSUES",
+		}, 1, 0),
+		repo:        repo,
+		app:         app,
+		clusterName: clusterName,
+	}
+	return e
+}
+
+func (e EKSNodegroups) GetLabels() []string {
+	return []string{e.clusterName, "Node Groups"}
+}
+
+func (e EKSNodegroups) healthIssuesHandler() {
+	name, err := e.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	healthIssuesView := NewEKSNodegroupHealthIssues(e.repo, e.clusterName, name, e.app)
+	e.app.AddAndSwitch(healthIssuesView)
+}
+
+func (e EKSNodegroups) tagsHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if arn := e.model[row-1].NodegroupArn; arn != nil {
+		tagsView := NewTags(e.repo, e.GetService(), *arn, e.app)
+		e.app.AddAndSwitch(tagsView)
+	}
+}
+
+func (e EKSNodegroups) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'h', tcell.ModNone),
+			Description: "Health Issues",
+			Action:      e.healthIssuesHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
+			Description: "Tags",
+			Action:      e.tagsHandler,
+		},
+	}
+}
+
+func (e *EKSNodegroups) Render() {
+	model, err := e.repo.ListNodegroups(e.clusterName)
+	if err != nil {
+		panic(err)
+	}
+	e.model = model
+
+	formatSize := func(v *int32) string {
+		if v == nil {
+			return "-"
+		}
+		return strconv.Itoa(int(*v))
+	}
+
+	var data [][]string
+	for _, v := range model {
+		var size string
+		if v.ScalingConfig != nil {
+			size = fmt.Sprintf("%v/%v/%v", formatSize(v.ScalingConfig.MinSize), formatSize(v.ScalingConfig.DesiredSize), formatSize(v.ScalingConfig.MaxSize))
+		}
+		healthIssues := 0
+		if v.Health != nil {
+			healthIssues = len(v.Health.Issues)
+		}
+		data = append(data, []string{
+			utils.DerefString(v.NodegroupName, ""),
+			utils.AutoCase(string(v.Status)),
+			utils.AutoCase(string(v.CapacityType)),
+			strings.Join(v.InstanceTypes, ", "),
+			size,
+			string(v.AmiType),
+			utils.DerefString(v.ReleaseVersion, ""),
+			strconv.Itoa(healthIssues),
+		})
+	}
+	e.SetData(data)
+}
@@ -62,6 +62,22 @@ func (e ElastiCacheClusters) serviceUpdateStatusHandler() {
 	e.app.AddAndSwitch(updateActionsView)
 }
 
+func (e ElastiCacheClusters) nodesHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if e.model[row-1].ReplicationGroup == nil {
+		return
+	}
+	id, err := e.GetColSelection("ID")
+	if err != nil {
+		return
+	}
+	nodesView := NewElastiCacheReplicationGroupNodes(e.repo, id, e.app)
+	e.app.AddAndSwitch(nodesView)
+}
+
 func (e ElastiCacheClusters) tagsHandler() {
 	row, err := e.GetRowSelection()
 	if err != nil {
@@ -86,6 +102,11 @@ func (e ElastiCacheClusters) tagsHandler() {
 
 func (e ElastiCacheClusters) GetKeyActions() []KeyAction {
 	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'n', tcell.ModNone),
+			Description: "Nodes",
+			Action:      e.nodesHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone),
 			Description: "Service Update Status",
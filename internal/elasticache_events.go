@@ -1,6 +1,9 @@
 package internal
 
 import (
+	"slices"
+	"time"
+
 	"github.com/bporter816/aws-tui/internal/repo"
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
@@ -10,11 +13,16 @@ import (
 type ElastiCacheEvents struct {
 	*ui.Table
 	view.ElastiCache
-	repo *repo.ElastiCache
-	app  *Application
+	repo       *repo.ElastiCache
+	sourceIds  []string
+	label      string
+	app        *Application
+	refreshing bool
 }
 
-func NewElastiCacheEvents(repo *repo.ElastiCache, app *Application) *ElastiCacheEvents {
+// NewElastiCacheEvents lists recent events. If sourceIds is non-empty, only events for those sources are
+// shown and the view keeps refreshing so that progress of ongoing operations can be followed.
+func NewElastiCacheEvents(repo *repo.ElastiCache, sourceIds []string, label string, app *Application) *ElastiCacheEvents {
 	e := &ElastiCacheEvents{
 		Table: ui.NewTable([]string{
 			"DATE",
@@ -22,13 +30,18 @@ func NewElastiCacheEvents(repo *repo.ElastiCache, app *Application) *ElastiCache
 			"TYPE",
 			"MESSAGE",
 		}, 1, 0),
-		repo: repo,
-		app:  app,
+		repo:      repo,
+		sourceIds: sourceIds,
+		label:     label,
+		app:       app,
 	}
 	return e
 }
 
 func (e ElastiCacheEvents) GetLabels() []string {
+	if e.label != "" {
+		return []string{e.label, "Events"}
+	}
 	return []string{"Events"}
 }
 
@@ -36,7 +49,7 @@ func (e ElastiCacheEvents) GetKeyActions() []KeyAction {
 	return []KeyAction{}
 }
 
-func (e ElastiCacheEvents) Render() {
+func (e *ElastiCacheEvents) Render() {
 	model, err := e.repo.ListEvents()
 	if err != nil {
 		panic(err)
@@ -44,6 +57,9 @@ func (e ElastiCacheEvents) Render() {
 
 	var data [][]string
 	for _, v := range model {
+		if len(e.sourceIds) > 0 && !slices.Contains(e.sourceIds, utils.DerefString(v.SourceIdentifier, "")) {
+			continue
+		}
 		var date string
 		if v.Date != nil {
 			date = v.Date.Format(utils.DefaultTimeFormat)
@@ -56,4 +72,11 @@ func (e ElastiCacheEvents) Render() {
 		})
 	}
 	e.SetData(data)
+
+	if !e.refreshing && len(e.sourceIds) > 0 {
+		e.refreshing = true
+		e.app.AutoRefresh(e, 10*time.Second, func() bool {
+			return true
+		})
+	}
 }
@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type ElastiCacheParameterDiff struct {
+	*ui.Table
+	view.ElastiCache
+	repo      *repo.ElastiCache
+	app       *Application
+	family    string
+	leftName  string
+	rightName string
+}
+
+// NewElastiCacheParameterDiff compares two parameter groups side by side. An empty rightName compares
+// against the engine defaults for the family instead.
+func NewElastiCacheParameterDiff(repo *repo.ElastiCache, app *Application, family string, leftName string, rightName string) *ElastiCacheParameterDiff {
+	rightHeader := "ENGINE DEFAULT"
+	if rightName != "" {
+		rightHeader = utils.UpperCase(rightName)
+	}
+	e := &ElastiCacheParameterDiff{
+		Table: ui.NewTable([]string{
+			"NAME",
+			utils.UpperCase(leftName),
+			rightHeader,
+		}, 1, 1),
+		repo:      repo,
+		app:       app,
+		family:    family,
+		leftName:  leftName,
+		rightName: rightName,
+	}
+	return e
+}
+
+func (e ElastiCacheParameterDiff) GetLabels() []string {
+	rightLabel := "Engine Defaults"
+	if e.rightName != "" {
+		rightLabel = e.rightName
+	}
+	return []string{e.leftName, "Diff", rightLabel}
+}
+
+func (e ElastiCacheParameterDiff) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (e ElastiCacheParameterDiff) listParameters(groupName string) (map[string]string, error) {
+	var parameters []model.ElastiCacheParameter
+	var err error
+	if groupName == "" {
+		parameters, err = e.repo.ListEngineDefaultParameters(e.family)
+	} else {
+		parameters, err = e.repo.ListParameters(groupName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string)
+	for _, v := range parameters {
+		values[utils.DerefString(v.ParameterName, "")] = utils.DerefString(v.ParameterValue, "")
+	}
+	return values, nil
+}
+
+func (e ElastiCacheParameterDiff) Render() {
+	left, err := e.listParameters(e.leftName)
+	if err != nil {
+		panic(err)
+	}
+	right, err := e.listParameters(e.rightName)
+	if err != nil {
+		panic(err)
+	}
+
+	var data [][]string
+	for _, name := range utils.DiffStringMaps(left, right) {
+		data = append(data, []string{
+			name,
+			left[name],
+			right[name],
+		})
+	}
+	e.SetData(data)
+}
@@ -42,6 +42,32 @@ func (e ElastiCacheParameterGroups) viewParametersHandler() {
 	e.app.AddAndSwitch(parametersView)
 }
 
+func (e ElastiCacheParameterGroups) diffHandler() {
+	name, err := e.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	family, err := e.GetColSelection("FAMILY")
+	if err != nil {
+		return
+	}
+	options := []string{"Engine Defaults (" + family + ")"}
+	for r := 1; r < e.GetRowCount(); r++ {
+		if other := e.GetCell(r, 0).Text; other != name {
+			options = append(options, other)
+		}
+	}
+	diffForm := NewParameterGroupDiffForm(e.app, e.GetService(), name, options, func(index int, option string) {
+		var rightName string
+		if index > 0 {
+			rightName = option
+		}
+		diffView := NewElastiCacheParameterDiff(e.repo, e.app, family, name, rightName)
+		e.app.AddAndSwitch(diffView)
+	})
+	e.app.AddAndSwitch(diffForm)
+}
+
 func (e ElastiCacheParameterGroups) GetKeyActions() []KeyAction {
 	return []KeyAction{
 		{
@@ -49,6 +75,11 @@ func (e ElastiCacheParameterGroups) GetKeyActions() []KeyAction {
 			Description: "View Parameters",
 			Action:      e.viewParametersHandler,
 		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'd', tcell.ModNone),
+			Description: "Diff",
+			Action:      e.diffHandler,
+		},
 	}
 }
 
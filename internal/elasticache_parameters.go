@@ -5,6 +5,7 @@ import (
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
 	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
 )
 
 type ElastiCacheParameters struct {
@@ -13,6 +14,7 @@ type ElastiCacheParameters struct {
 	repo               *repo.ElastiCache
 	parameterGroupName string
 	app                *Application
+	modifiedOnly       bool
 }
 
 func NewElastiCacheParameters(repo *repo.ElastiCache, parameterGroupName string, app *Application) *ElastiCacheParameters {
@@ -35,14 +37,28 @@ func NewElastiCacheParameters(repo *repo.ElastiCache, parameterGroupName string,
 }
 
 func (e ElastiCacheParameters) GetLabels() []string {
+	if e.modifiedOnly {
+		return []string{e.parameterGroupName, "Parameters", "Modified"}
+	}
 	return []string{e.parameterGroupName, "Parameters"}
 }
 
-func (e ElastiCacheParameters) GetKeyActions() []KeyAction {
-	return []KeyAction{}
+func (e *ElastiCacheParameters) modifiedOnlyHandler() {
+	e.modifiedOnly = !e.modifiedOnly
+	e.Render()
 }
 
-func (e ElastiCacheParameters) Render() {
+func (e *ElastiCacheParameters) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'm', tcell.ModNone),
+			Description: "Toggle Modified Only",
+			Action:      e.modifiedOnlyHandler,
+		},
+	}
+}
+
+func (e *ElastiCacheParameters) Render() {
 	model, err := e.repo.ListParameters(e.parameterGroupName)
 	if err != nil {
 		panic(err)
@@ -50,6 +66,9 @@ func (e ElastiCacheParameters) Render() {
 
 	var data [][]string
 	for _, v := range model {
+		if e.modifiedOnly && utils.DerefString(v.Source, "") != "user" {
+			continue
+		}
 		var isModifiable string
 		if v.IsModifiable != nil {
 			isModifiable = utils.BoolToString(*v.IsModifiable, "Yes", "No")
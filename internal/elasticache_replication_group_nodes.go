@@ -0,0 +1,196 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	ecTypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type ElastiCacheReplicationGroupNodes struct {
+	*ui.Table
+	view.ElastiCache
+	repo               *repo.ElastiCache
+	replicationGroupId string
+	app                *Application
+	replicationGroup   model.ElastiCacheReplicationGroup
+	model              []ecTypes.NodeGroupMember
+	inProgress         bool
+	refreshing         bool
+}
+
+func NewElastiCacheReplicationGroupNodes(repo *repo.ElastiCache, replicationGroupId string, app *Application) *ElastiCacheReplicationGroupNodes {
+	e := &ElastiCacheReplicationGroupNodes{
+		Table: ui.NewTable([]string{
+			"SHARD",
+			"SLOTS",
+			"NODE",
+			"ROLE",
+			"AZ",
+			"ENDPOINT",
+			"STATUS",
+		}, 1, 0),
+		repo:               repo,
+		replicationGroupId: replicationGroupId,
+		app:                app,
+	}
+	return e
+}
+
+func (e ElastiCacheReplicationGroupNodes) GetLabels() []string {
+	return []string{e.replicationGroupId, "Nodes"}
+}
+
+func (e *ElastiCacheReplicationGroupNodes) testFailoverHandler() {
+	shard, err := e.GetColSelection("SHARD")
+	if err != nil {
+		return
+	}
+	e.app.Confirm(e.GetService(), "Test failover of shard "+shard+" in "+e.replicationGroupId+"? A replica will be promoted to primary.", "Failover", func() {
+		if err := e.repo.TestFailover(e.replicationGroupId, shard); err != nil {
+			e.app.ShowError(e.GetService(), fmt.Sprintf("Test failover failed: %v", err))
+			return
+		}
+		e.eventsHandler()
+	})
+}
+
+func (e *ElastiCacheReplicationGroupNodes) rebootHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	member := e.model[row-1]
+	clusterId := utils.DerefString(member.CacheClusterId, "")
+	nodeId := utils.DerefString(member.CacheNodeId, "")
+	e.app.Confirm(e.GetService(), "Reboot node "+nodeId+" of "+clusterId+"?", "Reboot", func() {
+		if err := e.repo.RebootNode(clusterId, nodeId); err != nil {
+			e.app.ShowError(e.GetService(), fmt.Sprintf("Reboot node failed: %v", err))
+			return
+		}
+		e.Render()
+	})
+}
+
+func (e *ElastiCacheReplicationGroupNodes) modifyReplicaCountHandler() {
+	// every shard has the same number of replicas when they're changed from here, so use the first one
+	var replicaCount int
+	if len(e.replicationGroup.NodeGroups) > 0 {
+		replicaCount = len(e.replicationGroup.NodeGroups[0].NodeGroupMembers) - 1
+	}
+	replicaCountForm := NewElastiCacheModifyReplicaCountForm(e.repo, e.replicationGroupId, replicaCount, e.app, e.Render)
+	e.app.AddAndSwitch(replicaCountForm)
+}
+
+func (e ElastiCacheReplicationGroupNodes) eventsHandler() {
+	sourceIds := append([]string{e.replicationGroupId}, e.replicationGroup.MemberClusters...)
+	eventsView := NewElastiCacheEvents(e.repo, sourceIds, e.replicationGroupId, e.app)
+	e.app.AddAndSwitch(eventsView)
+}
+
+func (e *ElastiCacheReplicationGroupNodes) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'f', tcell.ModNone),
+			Description: "Test Failover",
+			Action:      e.testFailoverHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'b', tcell.ModNone),
+			Description: "Reboot Node",
+			Action:      e.rebootHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'R', tcell.ModNone),
+			Description: "Modify Replica Count",
+			Action:      e.modifyReplicaCountHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'E', tcell.ModNone),
+			Description: "Events",
+			Action:      e.eventsHandler,
+		},
+	}
+}
+
+func formatElastiCacheEndpoint(endpoint *ecTypes.Endpoint) string {
+	if endpoint == nil || endpoint.Address == nil {
+		return ""
+	}
+	if endpoint.Port == nil {
+		return *endpoint.Address
+	}
+	return *endpoint.Address + ":" + strconv.Itoa(int(*endpoint.Port))
+}
+
+func (e *ElastiCacheReplicationGroupNodes) Render() {
+	replicationGroup, err := e.repo.GetReplicationGroup(e.replicationGroupId)
+	if err != nil {
+		panic(err)
+	}
+	e.replicationGroup = replicationGroup
+
+	members, err := e.repo.ListReplicationGroupMembers(replicationGroup.MemberClusters)
+	if err != nil {
+		panic(err)
+	}
+	clusters := make(map[string]model.ElastiCacheCacheCluster)
+	for _, v := range members {
+		clusters[utils.DerefString(v.CacheClusterId, "")] = v
+	}
+
+	inProgress := utils.DerefString(replicationGroup.Status, "") != "available"
+	var nodeGroupMembers []ecTypes.NodeGroupMember
+	var data [][]string
+	for _, nodeGroup := range replicationGroup.NodeGroups {
+		for _, member := range nodeGroup.NodeGroupMembers {
+			nodeGroupMembers = append(nodeGroupMembers, member)
+			clusterId := utils.DerefString(member.CacheClusterId, "")
+			endpoint := formatElastiCacheEndpoint(member.ReadEndpoint)
+			var status string
+			if cluster, ok := clusters[clusterId]; ok {
+				status = utils.DerefString(cluster.CacheClusterStatus, "")
+				for _, node := range cluster.CacheNodes {
+					if utils.DerefString(node.CacheNodeId, "") == utils.DerefString(member.CacheNodeId, "") {
+						status = utils.DerefString(node.CacheNodeStatus, status)
+						if endpoint == "" {
+							endpoint = formatElastiCacheEndpoint(node.Endpoint)
+						}
+					}
+				}
+			}
+			if status != "available" {
+				inProgress = true
+			}
+			// roles are only reported for replication groups with cluster mode disabled
+			data = append(data, []string{
+				utils.DerefString(nodeGroup.NodeGroupId, ""),
+				utils.DerefString(nodeGroup.Slots, "-"),
+				clusterId,
+				utils.TitleCase(utils.DerefString(member.CurrentRole, "-")),
+				utils.DerefString(member.PreferredAvailabilityZone, ""),
+				endpoint,
+				utils.TitleCase(status),
+			})
+		}
+	}
+	e.model = nodeGroupMembers
+	e.inProgress = inProgress
+	e.SetData(data)
+
+	// follow failovers, reboots and replica changes until everything is available again
+	if !e.refreshing && e.inProgress {
+		e.refreshing = true
+		e.app.AutoRefresh(e, 10*time.Second, func() bool {
+			e.refreshing = e.inProgress
+			return e.refreshing
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type ELBListenerRules struct {
+	*ui.Table
+	view.ELB
+	repo        *repo.ELB
+	listenerArn string
+	labels      []string
+	app         *Application
+	model       []model.ELBListenerRule
+}
+
+func NewELBListenerRules(repo *repo.ELB, listenerArn string, labels []string, app *Application) *ELBListenerRules {
+	e := &ELBListenerRules{
+		Table: ui.NewTable([]string{
+			"PRIORITY",
+			"CONDITIONS",
+			"ACTIONS",
+		}, 1, 0),
+		repo:        repo,
+		listenerArn: listenerArn,
+		labels:      labels,
+		app:         app,
+	}
+	return e
+}
+
+func (e ELBListenerRules) GetLabels() []string {
+	return append(e.labels, "Rules")
+}
+
+func (e ELBListenerRules) tagsHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	if arn := e.model[row-1].RuleArn; arn != nil {
+		tagsView := NewTags(e.repo, e.GetService(), *arn, e.app)
+		e.app.AddAndSwitch(tagsView)
+	}
+}
+
+func (e ELBListenerRules) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
+			Description: "Tags",
+			Action:      e.tagsHandler,
+		},
+	}
+}
+
+func (e *ELBListenerRules) Render() {
+	model, err := e.repo.ListListenerRules(e.listenerArn)
+	if err != nil {
+		panic(err)
+	}
+	// rules are evaluated by priority, and the default rule goes last
+	sort.SliceStable(model, func(i, j int) bool {
+		pi, erri := strconv.Atoi(utils.DerefString(model[i].Priority, ""))
+		pj, errj := strconv.Atoi(utils.DerefString(model[j].Priority, ""))
+		if erri != nil || errj != nil {
+			return erri == nil
+		}
+		return pi < pj
+	})
+	e.model = model
+
+	var data [][]string
+	for _, v := range model {
+		conditions := utils.FormatELBRuleConditions(v.Conditions)
+		if v.IsDefault != nil && *v.IsDefault {
+			conditions = "-"
+		}
+		data = append(data, []string{
+			utils.TitleCase(utils.DerefString(v.Priority, "")),
+			conditions,
+			utils.FormatELBRuleActions(v.Actions),
+		})
+	}
+	e.SetData(data)
+}
@@ -45,6 +45,25 @@ func (e ELBListeners) GetLabels() []string {
 	return []string{e.lbName, "Listeners"}
 }
 
+func (e ELBListeners) rulesHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	protocol, err := e.GetColSelection("PROTOCOL")
+	if err != nil {
+		return
+	}
+	port, err := e.GetColSelection("PORT")
+	if err != nil {
+		return
+	}
+	if arn := e.model[row-1].ListenerArn; arn != nil {
+		rulesView := NewELBListenerRules(e.repo, *arn, []string{e.lbName, protocol + ":" + port}, e.app)
+		e.app.AddAndSwitch(rulesView)
+	}
+}
+
 func (e ELBListeners) tagsHandler() {
 	row, err := e.GetRowSelection()
 	if err != nil {
@@ -69,6 +88,11 @@ func (e ELBListeners) tagsHandler() {
 
 func (e ELBListeners) GetKeyActions() []KeyAction {
 	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone),
+			Description: "Rules",
+			Action:      e.rulesHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
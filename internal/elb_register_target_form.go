@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type ELBRegisterTargetForm struct {
+	*tview.Form
+	view.ELB
+	repo            *repo.ELB
+	targetGroupArn  string
+	targetGroupName string
+	app             *Application
+	onComplete      func()
+}
+
+func NewELBRegisterTargetForm(repo *repo.ELB, targetGroupArn string, targetGroupName string, app *Application, onComplete func()) *ELBRegisterTargetForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Register Target ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	e := &ELBRegisterTargetForm{
+		Form:            form,
+		repo:            repo,
+		targetGroupArn:  targetGroupArn,
+		targetGroupName: targetGroupName,
+		app:             app,
+		onComplete:      onComplete,
+	}
+
+	form.AddInputField("Target ID", "", 0, nil, nil)
+	form.AddInputField("Port", "", 6, tview.InputFieldInteger, nil)
+	form.AddTextView("", "Instance ID, IP address or Lambda function ARN. Leave the port empty to use the target group's port.", 0, 2, false, false)
+	form.AddButton("Register", e.registerHandler)
+	form.AddButton("Cancel", e.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return e
+}
+
+func (e *ELBRegisterTargetForm) registerHandler() {
+	id := strings.TrimSpace(e.GetFormItem(0).(*tview.InputField).GetText())
+	portStr := e.GetFormItem(1).(*tview.InputField).GetText()
+	if len(id) == 0 {
+		e.app.ShowError(e.GetService(), "Target ID is required")
+		return
+	}
+	var port int
+	if portStr != "" {
+		var err error
+		port, err = strconv.Atoi(portStr)
+		if err != nil || port < 1 || port > 65535 {
+			e.app.ShowError(e.GetService(), "Port must be between 1 and 65535")
+			return
+		}
+	}
+
+	if err := e.repo.RegisterTarget(e.targetGroupArn, id, int32(port)); err != nil {
+		e.app.ShowError(e.GetService(), fmt.Sprintf("Register target failed: %v", err))
+		return
+	}
+
+	e.app.Close()
+	if e.onComplete != nil {
+		e.onComplete()
+	}
+}
+
+func (e *ELBRegisterTargetForm) cancelHandler() {
+	e.app.Close()
+}
+
+func (e ELBRegisterTargetForm) GetLabels() []string {
+	return []string{e.targetGroupName, "Register Target"}
+}
+
+func (e ELBRegisterTargetForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (e ELBRegisterTargetForm) Render() {
+}
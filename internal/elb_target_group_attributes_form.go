@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type ELBTargetGroupAttributesForm struct {
+	*tview.Form
+	view.ELB
+	repo            *repo.ELB
+	targetGroupArn  string
+	targetGroupName string
+	app             *Application
+	onComplete      func()
+	attributes      map[string]string
+	keys            []string
+}
+
+// NewELBTargetGroupAttributesForm edits the attributes of a target group, such as stickiness, slow start and the
+// deregistration delay. Boolean attributes are shown as checkboxes and everything else as text. The attributes are
+// loaded once, so refreshing doesn't discard unsaved edits.
+func NewELBTargetGroupAttributesForm(repo *repo.ELB, targetGroupArn string, targetGroupName string, app *Application, onComplete func()) *ELBTargetGroupAttributesForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Target Group Attributes ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	e := &ELBTargetGroupAttributesForm{
+		Form:            form,
+		repo:            repo,
+		targetGroupArn:  targetGroupArn,
+		targetGroupName: targetGroupName,
+		app:             app,
+		onComplete:      onComplete,
+	}
+
+	attributes, err := repo.GetTargetGroupAttributes(targetGroupArn)
+	if err != nil {
+		panic(err)
+	}
+	e.attributes = attributes
+	for k := range attributes {
+		e.keys = append(e.keys, k)
+	}
+	sort.Strings(e.keys)
+	for _, k := range e.keys {
+		v := attributes[k]
+		if v == "true" || v == "false" {
+			form.AddCheckbox(k, v == "true", nil)
+		} else {
+			form.AddInputField(k, v, 0, nil, nil)
+		}
+	}
+	form.AddButton("Save", e.saveHandler)
+	form.AddButton("Cancel", e.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return e
+}
+
+func (e *ELBTargetGroupAttributesForm) saveHandler() {
+	changed := make(map[string]string)
+	for i, k := range e.keys {
+		var value string
+		switch item := e.GetFormItem(i).(type) {
+		case *tview.Checkbox:
+			value = "false"
+			if item.IsChecked() {
+				value = "true"
+			}
+		case *tview.InputField:
+			value = item.GetText()
+		}
+		if value != e.attributes[k] {
+			changed[k] = value
+		}
+	}
+
+	if len(changed) > 0 {
+		if err := e.repo.SetTargetGroupAttributes(e.targetGroupArn, changed); err != nil {
+			e.app.ShowError(e.GetService(), fmt.Sprintf("Save attributes failed: %v", err))
+			return
+		}
+	}
+	e.app.Close()
+	if len(changed) > 0 && e.onComplete != nil {
+		e.onComplete()
+	}
+}
+
+func (e *ELBTargetGroupAttributesForm) cancelHandler() {
+	e.app.Close()
+}
+
+func (e ELBTargetGroupAttributesForm) GetLabels() []string {
+	return []string{e.targetGroupName, "Attributes"}
+}
+
+func (e ELBTargetGroupAttributesForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (e *ELBTargetGroupAttributesForm) Render() {
+}
@@ -38,6 +38,36 @@ func (e ELBTargetGroups) GetLabels() []string {
 	return []string{"Target Groups"}
 }
 
+func (e ELBTargetGroups) targetHealthHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	name, err := e.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	if arn := e.model[row-1].TargetGroupArn; arn != nil {
+		targetHealthView := NewELBTargetHealth(e.repo, *arn, name, e.app)
+		e.app.AddAndSwitch(targetHealthView)
+	}
+}
+
+func (e ELBTargetGroups) attributesHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	name, err := e.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	if arn := e.model[row-1].TargetGroupArn; arn != nil {
+		attributesForm := NewELBTargetGroupAttributesForm(e.repo, *arn, name, e.app, nil)
+		e.app.AddAndSwitch(attributesForm)
+	}
+}
+
 func (e ELBTargetGroups) tagsHandler() {
 	row, err := e.GetRowSelection()
 	if err != nil {
@@ -51,6 +81,16 @@ func (e ELBTargetGroups) tagsHandler() {
 
 func (e ELBTargetGroups) GetKeyActions() []KeyAction {
 	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'h', tcell.ModNone),
+			Description: "Target Health",
+			Action:      e.targetHealthHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone),
+			Description: "Attributes",
+			Action:      e.attributesHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
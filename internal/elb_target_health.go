@@ -0,0 +1,174 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	elbTypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type ELBTargetHealth struct {
+	*ui.Table
+	view.ELB
+	repo                *repo.ELB
+	targetGroupArn      string
+	targetGroupName     string
+	app                 *Application
+	model               []model.ELBTargetHealth
+	deregistrationDelay string
+	refreshing          bool
+}
+
+func NewELBTargetHealth(repo *repo.ELB, targetGroupArn string, targetGroupName string, app *Application) *ELBTargetHealth {
+	e := &ELBTargetHealth{
+		Table: ui.NewTable([]string{
+			"TARGET",
+			"PORT",
+			"AZ",
+			"HEALTH CHECK PORT",
+			"STATE",
+			"REASON",
+			"DESCRIPTION",
+		}, 1, 0),
+		repo:            repo,
+		targetGroupArn:  targetGroupArn,
+		targetGroupName: targetGroupName,
+		app:             app,
+	}
+	return e
+}
+
+func (e ELBTargetHealth) GetLabels() []string {
+	if e.deregistrationDelay != "" {
+		return []string{e.targetGroupName, "Target Health", "Deregistration Delay " + e.deregistrationDelay}
+	}
+	return []string{e.targetGroupName, "Target Health"}
+}
+
+func (e *ELBTargetHealth) registerHandler() {
+	registerForm := NewELBRegisterTargetForm(e.repo, e.targetGroupArn, e.targetGroupName, e.app, e.Render)
+	e.app.AddAndSwitch(registerForm)
+}
+
+func (e *ELBTargetHealth) deregisterHandler() {
+	row, err := e.GetRowSelection()
+	if err != nil {
+		return
+	}
+	target := e.model[row-1].Target
+	if target == nil || target.Id == nil {
+		return
+	}
+	var port int32
+	name := *target.Id
+	if target.Port != nil {
+		port = *target.Port
+		name += ":" + strconv.Itoa(int(port))
+	}
+	message := "Deregister " + name + " from " + e.targetGroupName + "?"
+	if e.deregistrationDelay != "" {
+		message += " In-flight requests are drained for up to " + e.deregistrationDelay + "."
+	}
+	e.app.Confirm(e.GetService(), message, "Deregister", func() {
+		if err := e.repo.DeregisterTarget(e.targetGroupArn, *target.Id, port); err != nil {
+			e.app.ShowError(e.GetService(), fmt.Sprintf("Deregister target failed: %v", err))
+			return
+		}
+		e.Render()
+	})
+}
+
+func (e *ELBTargetHealth) attributesHandler() {
+	attributesForm := NewELBTargetGroupAttributesForm(e.repo, e.targetGroupArn, e.targetGroupName, e.app, e.Render)
+	e.app.AddAndSwitch(attributesForm)
+}
+
+func (e *ELBTargetHealth) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone),
+			Description: "Register Target",
+			Action:      e.registerHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'd', tcell.ModNone),
+			Description: "Deregister Target",
+			Action:      e.deregisterHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone),
+			Description: "Attributes",
+			Action:      e.attributesHandler,
+		},
+	}
+}
+
+// isTransitioning reports whether any target is still being registered or drained
+func (e ELBTargetHealth) isTransitioning() bool {
+	for _, v := range e.model {
+		if v.TargetHealth == nil {
+			continue
+		}
+		if v.TargetHealth.State == elbTypes.TargetHealthStateEnumInitial || v.TargetHealth.State == elbTypes.TargetHealthStateEnumDraining {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *ELBTargetHealth) Render() {
+	model, err := e.repo.ListTargetHealth(e.targetGroupArn)
+	if err != nil {
+		panic(err)
+	}
+	e.model = model
+
+	if attributes, err := e.repo.GetTargetGroupAttributes(e.targetGroupArn); err == nil {
+		if v, err := strconv.ParseInt(attributes["deregistration_delay.timeout_seconds"], 10, 64); err == nil {
+			e.deregistrationDelay = utils.FormatSeconds(v)
+		}
+	}
+
+	var data [][]string
+	for _, v := range model {
+		var id, port, az, state, reason, description string
+		if t := v.Target; t != nil {
+			id = utils.DerefString(t.Id, "")
+			if t.Port != nil {
+				port = strconv.Itoa(int(*t.Port))
+			}
+			az = utils.DerefString(t.AvailabilityZone, "")
+		}
+		if h := v.TargetHealth; h != nil {
+			state = utils.TitleCase(string(h.State))
+			reason = string(h.Reason)
+			description = utils.DerefString(h.Description, "")
+		}
+		data = append(data, []string{
+			id,
+			port,
+			az,
+			utils.DerefString(v.HealthCheckPort, ""),
+			state,
+			reason,
+			description,
+		})
+	}
+	e.SetData(data)
+
+	// follow registrations and draining until every target settles
+	if !e.refreshing && e.isTransitioning() {
+		e.refreshing = true
+		e.app.AutoRefresh(e, 5*time.Second, func() bool {
+			e.refreshing = e.isTransitioning()
+			return e.refreshing
+		})
+	}
+}
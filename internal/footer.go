@@ -2,22 +2,35 @@ package internal
 
 import (
 	"github.com/rivo/tview"
+	"sort"
 	"strings"
 )
 
 type Footer struct {
 	*tview.TextView
-	app *Application
+	app      *Application
+	statuses map[string]string
 }
 
 func NewFooter(app *Application) *Footer {
 	f := &Footer{
 		TextView: tview.NewTextView().SetDynamicColors(true),
 		app:      app,
+		statuses: make(map[string]string),
 	}
 	return f
 }
 
+// SetStatus shows a status message for a background task after the breadcrumbs, or removes it if status is empty
+func (f *Footer) SetStatus(key, status string) {
+	if status == "" {
+		delete(f.statuses, key)
+	} else {
+		f.statuses[key] = status
+	}
+	f.Render()
+}
+
 func (f Footer) Render() {
 	var names []string
 	for i, v := range f.app.components {
@@ -27,5 +40,14 @@ func (f Footer) Render() {
 		names = append(names, v.GetLabels()...)
 	}
 	str := strings.Join(names, " > ")
+
+	var keys []string
+	for k := range f.statuses {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		str += "  [yellow]" + tview.Escape(f.statuses[k]) + "[-]"
+	}
 	f.SetText(str)
 }
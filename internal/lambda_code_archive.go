@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type LambdaCodeArchive struct {
+	*ui.Tree
+	view.Lambda
+	repo         *repo.Lambda
+	functionName string
+	app          *Application
+	reader       *zip.Reader
+	files        map[string]*zip.File
+}
+
+func NewLambdaCodeArchive(repo *repo.Lambda, functionName string, app *Application) *LambdaCodeArchive {
+	root := tview.NewTreeNode(functionName + ".zip")
+	root.SetReference("")
+
+	l := &LambdaCodeArchive{
+		Tree:         ui.NewTree(root),
+		repo:         repo,
+		functionName: functionName,
+		app:          app,
+	}
+	l.SetSelectedFunc(l.selectHandler)
+	return l
+}
+
+func (l LambdaCodeArchive) GetLabels() []string {
+	return []string{l.functionName, "Code"}
+}
+
+func (l LambdaCodeArchive) selectHandler(n *tview.TreeNode) {
+	if strings.HasSuffix(n.GetReference().(string), "/") {
+		n.SetExpanded(!n.IsExpanded())
+		return
+	}
+	l.fileHandler()
+}
+
+func (l LambdaCodeArchive) fileHandler() {
+	node := l.GetCurrentNode()
+	if node == nil {
+		return
+	}
+	if f, ok := l.files[node.GetReference().(string)]; ok {
+		// entries can use compression methods archive/zip doesn't support
+		r, err := f.Open()
+		if err != nil {
+			l.app.ShowError(l.GetService(), fmt.Sprintf("Cannot open %v: %v", f.Name, err))
+			return
+		}
+		r.Close()
+		fileView := NewLambdaCodeFile(l.functionName, f, l.app)
+		l.app.AddAndSwitch(fileView)
+	}
+}
+
+func (l LambdaCodeArchive) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone),
+			Description: "View File",
+			Action:      l.fileHandler,
+		},
+	}
+}
+
+// load downloads the deployment package. It is done once before the view opens, since container image functions and
+// packages that aren't zip archives can't be browsed.
+func (l *LambdaCodeArchive) load() error {
+	code, err := l.repo.GetFunctionCode(l.functionName)
+	if err != nil {
+		return err
+	}
+	reader, err := zip.NewReader(bytes.NewReader(code), int64(len(code)))
+	if err != nil {
+		return fmt.Errorf("deployment package is not a zip archive: %w", err)
+	}
+	l.reader = reader
+	return nil
+}
+
+func (l *LambdaCodeArchive) Render() {
+	if l.reader == nil {
+		return
+	}
+	reader := l.reader
+
+	l.files = make(map[string]*zip.File)
+	var names []string
+	for _, f := range reader.File {
+		if strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		l.files[f.Name] = f
+		names = append(names, f.Name)
+	}
+	sort.Strings(names)
+
+	root := l.GetRoot()
+	root.ClearChildren()
+	dirs := map[string]*tview.TreeNode{"": root}
+	for _, name := range names {
+		parts := strings.Split(name, "/")
+		parent := root
+		prefix := ""
+		for _, dir := range parts[:len(parts)-1] {
+			prefix += dir + "/"
+			n, ok := dirs[prefix]
+			if !ok {
+				n = tview.NewTreeNode(dir + "/")
+				n.SetColor(tcell.ColorGreen)
+				n.SetReference(prefix)
+				n.SetExpanded(false)
+				parent.AddChild(n)
+				dirs[prefix] = n
+			}
+			parent = n
+		}
+		leaf := tview.NewTreeNode(parts[len(parts)-1])
+		leaf.SetReference(name)
+		parent.AddChild(leaf)
+	}
+	root.SetExpanded(true)
+}
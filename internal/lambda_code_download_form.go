@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type LambdaCodeDownloadForm struct {
+	*tview.Form
+	view.Lambda
+	repo         *repo.Lambda
+	functionName string
+	settings     *settings.Settings
+	app          *Application
+}
+
+func NewLambdaCodeDownloadForm(repo *repo.Lambda, functionName string, settings *settings.Settings, app *Application) *LambdaCodeDownloadForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Download Code ")
+	form.SetTitleColor(tcell.ColorBlue)
+
+	l := &LambdaCodeDownloadForm{
+		Form:         form,
+		repo:         repo,
+		functionName: functionName,
+		settings:     settings,
+		app:          app,
+	}
+
+	form.AddInputField("Function", functionName, 0, nil, nil).
+		AddInputField("Local Directory", settings.GetLocalDirectory(), 0, nil, nil).
+		AddInputField("Filename", functionName+".zip", 0, nil, nil)
+
+	form.AddButton("Download", l.downloadHandler)
+	form.AddButton("Cancel", l.cancelHandler)
+
+	// Make read-only fields non-editable
+	form.GetFormItem(0).(*tview.InputField).SetDisabled(true)
+	form.GetFormItem(1).(*tview.InputField).SetDisabled(true)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorBlue)
+	form.SetButtonTextColor(tcell.ColorWhite)
+
+	return l
+}
+
+func (l *LambdaCodeDownloadForm) downloadHandler() {
+	dirPath := l.GetFormItem(1).(*tview.InputField).GetText()
+	filename := l.GetFormItem(2).(*tview.InputField).GetText()
+	destPath := filepath.Join(dirPath, filename)
+
+	if err := l.repo.DownloadFunctionCode(l.functionName, destPath); err != nil {
+		l.app.ShowError(l.GetService(), fmt.Sprintf("Download failed: %v", err))
+		return
+	}
+
+	l.app.Close()
+}
+
+func (l *LambdaCodeDownloadForm) cancelHandler() {
+	l.app.Close()
+}
+
+func (l LambdaCodeDownloadForm) GetLabels() []string {
+	return []string{l.functionName, "Download Code"}
+}
+
+func (l LambdaCodeDownloadForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (l LambdaCodeDownloadForm) Render() {
+}
@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type LambdaCodeFile struct {
+	*ui.Text
+	view.Lambda
+	functionName string
+	file         *zip.File
+	app          *Application
+}
+
+func NewLambdaCodeFile(functionName string, file *zip.File, app *Application) *LambdaCodeFile {
+	// the lexer is looked up by file extension, falling back to the file name for files like Dockerfile
+	lang := strings.TrimPrefix(filepath.Ext(file.Name), ".")
+	if len(lang) == 0 {
+		lang = filepath.Base(file.Name)
+	}
+	l := &LambdaCodeFile{
+		Text:         ui.NewText(true, lang),
+		functionName: functionName,
+		file:         file,
+		app:          app,
+	}
+	return l
+}
+
+func (l LambdaCodeFile) GetLabels() []string {
+	return []string{l.functionName, l.file.Name}
+}
+
+func (l LambdaCodeFile) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (l LambdaCodeFile) Render() {
+	r, err := l.file.Open()
+	if err != nil {
+		l.HighlightSyntax = false
+		l.SetText(fmt.Sprintf("<cannot open file: %v>", err))
+		return
+	}
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		l.HighlightSyntax = false
+		l.SetText(fmt.Sprintf("<cannot read file: %v>", err))
+		return
+	}
+
+	if utils.IsBinary(b) {
+		l.HighlightSyntax = false
+		l.SetText(fmt.Sprintf("<binary file, %v>", utils.FormatSize(int64(len(b)), 1)))
+		return
+	}
+	l.SetText(string(b))
+}
@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type LambdaFunctionAliases struct {
+	*ui.Table
+	view.Lambda
+	repo         *repo.Lambda
+	app          *Application
+	functionName string
+}
+
+func NewLambdaFunctionAliases(repo *repo.Lambda, functionName string, app *Application) *LambdaFunctionAliases {
+	l := &LambdaFunctionAliases{
+		Table: ui.NewTable([]string{
+			"NAME",
+			"VERSION",
+			"ADDITIONAL VERSIONS",
+			"DESCRIPTION",
+		}, 1, 0),
+		repo:         repo,
+		app:          app,
+		functionName: functionName,
+	}
+	return l
+}
+
+func (l LambdaFunctionAliases) GetLabels() []string {
+	return []string{l.functionName, "Aliases"}
+}
+
+func (l LambdaFunctionAliases) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (l LambdaFunctionAliases) Render() {
+	model, err := l.repo.ListAliases(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+
+	var data [][]string
+	for _, v := range model {
+		additionalVersions := "-"
+		if v.RoutingConfig != nil && len(v.RoutingConfig.AdditionalVersionWeights) > 0 {
+			var weights []string
+			for version, weight := range v.RoutingConfig.AdditionalVersionWeights {
+				weights = append(weights, fmt.Sprintf("%v (%v%%)", version, utils.SimplifyFloat(weight*100)))
+			}
+			sort.Strings(weights)
+			additionalVersions = strings.Join(weights, ", ")
+		}
+		data = append(data, []string{
+			utils.DerefString(v.Name, ""),
+			utils.DerefString(v.FunctionVersion, ""),
+			additionalVersions,
+			utils.DerefString(v.Description, ""),
+		})
+	}
+	l.SetData(data)
+}
@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type LambdaFunctionConcurrency struct {
+	*ui.Table
+	view.Lambda
+	repo         *repo.Lambda
+	app          *Application
+	functionName string
+}
+
+func NewLambdaFunctionConcurrency(repo *repo.Lambda, functionName string, app *Application) *LambdaFunctionConcurrency {
+	l := &LambdaFunctionConcurrency{
+		Table: ui.NewTable([]string{
+			"TYPE",
+			"QUALIFIER",
+			"REQUESTED",
+			"ALLOCATED",
+			"AVAILABLE",
+			"STATUS",
+			"REASON",
+		}, 1, 0),
+		repo:         repo,
+		app:          app,
+		functionName: functionName,
+	}
+	return l
+}
+
+func (l LambdaFunctionConcurrency) GetLabels() []string {
+	return []string{l.functionName, "Concurrency"}
+}
+
+func (l LambdaFunctionConcurrency) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (l LambdaFunctionConcurrency) Render() {
+	reserved, err := l.repo.GetFunctionConcurrency(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+	model, err := l.repo.ListProvisionedConcurrencyConfigs(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+
+	var data [][]string
+	if reserved != nil {
+		data = append(data, []string{
+			"Reserved",
+			"-",
+			strconv.Itoa(int(*reserved)),
+			"-",
+			"-",
+			"-",
+			"-",
+		})
+	}
+	formatCount := func(v *int32) string {
+		if v == nil {
+			return "-"
+		}
+		return strconv.Itoa(int(*v))
+	}
+	for _, v := range model {
+		var qualifier string
+		if v.FunctionArn != nil {
+			qualifier = (*v.FunctionArn)[strings.LastIndex(*v.FunctionArn, ":")+1:]
+		}
+		data = append(data, []string{
+			"Provisioned",
+			qualifier,
+			formatCount(v.RequestedProvisionedConcurrentExecutions),
+			formatCount(v.AllocatedProvisionedConcurrentExecutions),
+			formatCount(v.AvailableProvisionedConcurrentExecutions),
+			utils.AutoCase(string(v.Status)),
+			utils.DerefString(v.StatusReason, "-"),
+		})
+	}
+	l.SetData(data)
+}
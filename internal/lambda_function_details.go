@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type LambdaFunctionDetails struct {
+	*ui.Table
+	view.Lambda
+	repo         *repo.Lambda
+	app          *Application
+	functionName string
+}
+
+func NewLambdaFunctionDetails(repo *repo.Lambda, functionName string, app *Application) *LambdaFunctionDetails {
+	l := &LambdaFunctionDetails{
+		Table: ui.NewTable([]string{
+			"KEY",
+			"VALUE",
+		}, 1, 0),
+		repo:         repo,
+		app:          app,
+		functionName: functionName,
+	}
+	return l
+}
+
+func (l LambdaFunctionDetails) GetLabels() []string {
+	return []string{l.functionName, "Details"}
+}
+
+func (l LambdaFunctionDetails) environmentHandler() {
+	environmentView := NewLambdaFunctionEnvironment(l.repo, l.functionName, l.app)
+	l.app.AddAndSwitch(environmentView)
+}
+
+func (l LambdaFunctionDetails) layersHandler() {
+	layersView := NewLambdaFunctionLayers(l.repo, l.functionName, l.app)
+	l.app.AddAndSwitch(layersView)
+}
+
+func (l LambdaFunctionDetails) aliasesHandler() {
+	aliasesView := NewLambdaFunctionAliases(l.repo, l.functionName, l.app)
+	l.app.AddAndSwitch(aliasesView)
+}
+
+func (l LambdaFunctionDetails) versionsHandler() {
+	versionsView := NewLambdaFunctionVersions(l.repo, l.functionName, l.app)
+	l.app.AddAndSwitch(versionsView)
+}
+
+func (l LambdaFunctionDetails) concurrencyHandler() {
+	concurrencyView := NewLambdaFunctionConcurrency(l.repo, l.functionName, l.app)
+	l.app.AddAndSwitch(concurrencyView)
+}
+
+func (l LambdaFunctionDetails) eventSourceMappingsHandler() {
+	eventSourceMappingsView := NewLambdaFunctionEventSourceMappings(l.repo, l.functionName, l.app)
+	l.app.AddAndSwitch(eventSourceMappingsView)
+}
+
+func (l LambdaFunctionDetails) urlsHandler() {
+	urlsView := NewLambdaFunctionUrls(l.repo, l.functionName, l.app)
+	l.app.AddAndSwitch(urlsView)
+}
+
+func (l LambdaFunctionDetails) policyHandler() {
+	policyView := NewLambdaFunctionPolicy(l.repo, l.functionName, l.app)
+	l.app.AddAndSwitch(policyView)
+}
+
+func (l LambdaFunctionDetails) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'e', tcell.ModNone),
+			Description: "Environment",
+			Action:      l.environmentHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'l', tcell.ModNone),
+			Description: "Layers",
+			Action:      l.layersHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone),
+			Description: "Aliases",
+			Action:      l.aliasesHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone),
+			Description: "Versions",
+			Action:      l.versionsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'c', tcell.ModNone),
+			Description: "Concurrency",
+			Action:      l.concurrencyHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'm', tcell.ModNone),
+			Description: "Event Source Mappings",
+			Action:      l.eventSourceMappingsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'u', tcell.ModNone),
+			Description: "Function URLs",
+			Action:      l.urlsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'p', tcell.ModNone),
+			Description: "Resource Policy",
+			Action:      l.policyHandler,
+		},
+	}
+}
+
+func (l LambdaFunctionDetails) Render() {
+	model, err := l.repo.GetFunction(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+	reservedConcurrency, err := l.repo.GetFunctionConcurrency(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+
+	var architectures []string
+	for _, v := range model.Architectures {
+		architectures = append(architectures, string(v))
+	}
+	var memory, ephemeralStorage, timeout string
+	if model.MemorySize != nil {
+		memory = utils.FormatSize(int64(*model.MemorySize)<<20, 1)
+	}
+	if model.EphemeralStorage != nil && model.EphemeralStorage.Size != nil {
+		ephemeralStorage = utils.FormatSize(int64(*model.EphemeralStorage.Size)<<20, 1)
+	}
+	if model.Timeout != nil {
+		timeout = strconv.FormatInt(int64(*model.Timeout), 10) + " sec"
+	}
+	var tracing string
+	if model.TracingConfig != nil {
+		tracing = utils.AutoCase(string(model.TracingConfig.Mode))
+	}
+	var vpcId, subnets, securityGroups, ipv6DualStack string
+	if model.VpcConfig != nil && model.VpcConfig.VpcId != nil && len(*model.VpcConfig.VpcId) > 0 {
+		vpcId = *model.VpcConfig.VpcId
+		subnets = strings.Join(model.VpcConfig.SubnetIds, ", ")
+		securityGroups = strings.Join(model.VpcConfig.SecurityGroupIds, ", ")
+		if model.VpcConfig.Ipv6AllowedForDualStack != nil {
+			ipv6DualStack = utils.BoolToString(*model.VpcConfig.Ipv6AllowedForDualStack, "Yes", "No")
+		}
+	} else {
+		vpcId = "-"
+	}
+	var reserved string
+	if reservedConcurrency != nil {
+		reserved = strconv.Itoa(int(*reservedConcurrency))
+	} else {
+		reserved = "Unreserved"
+	}
+
+	data := [][]string{
+		{"Name", utils.DerefString(model.FunctionName, "")},
+		{"ARN", utils.DerefString(model.FunctionArn, "")},
+		{"Description", utils.DerefString(model.Description, "")},
+		{"State", utils.TitleCase(string(model.State))},
+		{"Last Update Status", utils.TitleCase(string(model.LastUpdateStatus))},
+		{"Package Type", utils.TitleCase(string(model.PackageType))},
+		{"Runtime", string(model.Runtime)},
+		{"Handler", utils.DerefString(model.Handler, "")},
+		{"Architectures", strings.Join(architectures, ", ")},
+		{"Code Size", utils.FormatSize(model.CodeSize, 1)},
+		{"Code SHA256", utils.DerefString(model.CodeSha256, "")},
+		{"Memory", memory},
+		{"Ephemeral Storage", ephemeralStorage},
+		{"Timeout", timeout},
+		{"Role", utils.DerefString(model.Role, "")},
+		{"Tracing", tracing},
+		{"Last Modified", utils.DerefString(model.LastModified, "")},
+		{"VPC ID", vpcId},
+		{"Subnets", subnets},
+		{"Security Groups", securityGroups},
+		{"IPv6 Dual Stack", ipv6DualStack},
+		{"Reserved Concurrency", reserved},
+	}
+	l.SetData(data)
+}
@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"sort"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type LambdaFunctionEnvironment struct {
+	*ui.Table
+	view.Lambda
+	repo         *repo.Lambda
+	app          *Application
+	functionName string
+	variables    map[string]string
+	masked       *maskedValues
+}
+
+func NewLambdaFunctionEnvironment(repo *repo.Lambda, functionName string, app *Application) *LambdaFunctionEnvironment {
+	l := &LambdaFunctionEnvironment{
+		Table: ui.NewTable([]string{
+			"KEY",
+			"VALUE",
+		}, 1, 0),
+		repo:         repo,
+		app:          app,
+		functionName: functionName,
+		masked:       newMaskedValues(),
+	}
+	return l
+}
+
+func (l LambdaFunctionEnvironment) GetLabels() []string {
+	return []string{l.functionName, "Environment"}
+}
+
+func (l *LambdaFunctionEnvironment) revealHandler() {
+	key, err := l.GetColSelection("KEY")
+	if err != nil {
+		return
+	}
+	l.masked.Toggle(key)
+	l.setData()
+}
+
+func (l *LambdaFunctionEnvironment) copyHandler() {
+	key, err := l.GetColSelection("KEY")
+	if err != nil {
+		return
+	}
+	l.app.CopyToClipboard(l.GetService(), l.variables[key])
+}
+
+func (l *LambdaFunctionEnvironment) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone),
+			Description: "Reveal/Hide Value",
+			Action:      l.revealHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'y', tcell.ModNone),
+			Description: "Copy Value",
+			Action:      l.copyHandler,
+		},
+	}
+}
+
+func (l *LambdaFunctionEnvironment) setData() {
+	var keys []string
+	for k := range l.variables {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var data [][]string
+	for _, k := range keys {
+		data = append(data, []string{
+			k,
+			l.masked.Format(k, l.variables[k]),
+		})
+	}
+	l.SetData(data)
+}
+
+func (l *LambdaFunctionEnvironment) Render() {
+	model, err := l.repo.GetFunction(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+	l.variables = map[string]string{}
+	if model.Environment != nil {
+		l.variables = model.Environment.Variables
+	}
+	l.setData()
+}
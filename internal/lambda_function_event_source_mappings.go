@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type LambdaFunctionEventSourceMappings struct {
+	*ui.Table
+	view.Lambda
+	repo         *repo.Lambda
+	app          *Application
+	functionName string
+}
+
+func NewLambdaFunctionEventSourceMappings(repo *repo.Lambda, functionName string, app *Application) *LambdaFunctionEventSourceMappings {
+	l := &LambdaFunctionEventSourceMappings{
+		Table: ui.NewTable([]string{
+			"UUID",
+			"SOURCE",
+			"SERVICE",
+			"STATE",
+			"BATCH SIZE",
+			"LAST RESULT",
+			"LAST MODIFIED",
+		}, 1, 0),
+		repo:         repo,
+		app:          app,
+		functionName: functionName,
+	}
+	return l
+}
+
+func (l LambdaFunctionEventSourceMappings) GetLabels() []string {
+	return []string{l.functionName, "Event Source Mappings"}
+}
+
+func (l LambdaFunctionEventSourceMappings) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (l LambdaFunctionEventSourceMappings) Render() {
+	model, err := l.repo.ListEventSourceMappings(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+
+	var data [][]string
+	for _, v := range model {
+		var source, service, batchSize, lastModified string
+		if v.EventSourceArn != nil {
+			if a, err := arn.Parse(*v.EventSourceArn); err == nil {
+				source = utils.GetResourceNameFromArn(a)
+				service = a.Service
+			} else {
+				source = *v.EventSourceArn
+			}
+		} else if v.SelfManagedEventSource != nil {
+			source = "Self-managed"
+		}
+		if v.BatchSize != nil {
+			batchSize = strconv.Itoa(int(*v.BatchSize))
+		}
+		if v.LastModified != nil {
+			lastModified = v.LastModified.Format(utils.DefaultTimeFormat)
+		}
+		data = append(data, []string{
+			utils.DerefString(v.UUID, ""),
+			source,
+			service,
+			utils.DerefString(v.State, ""),
+			batchSize,
+			utils.DerefString(v.LastProcessingResult, "-"),
+			lastModified,
+		})
+	}
+	l.SetData(data)
+}
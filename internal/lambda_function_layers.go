@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type LambdaFunctionLayers struct {
+	*ui.Table
+	view.Lambda
+	repo         *repo.Lambda
+	app          *Application
+	functionName string
+}
+
+func NewLambdaFunctionLayers(repo *repo.Lambda, functionName string, app *Application) *LambdaFunctionLayers {
+	l := &LambdaFunctionLayers{
+		Table: ui.NewTable([]string{
+			"NAME",
+			"VERSION",
+			"CODE SIZE",
+			"ARN",
+		}, 1, 0),
+		repo:         repo,
+		app:          app,
+		functionName: functionName,
+	}
+	return l
+}
+
+func (l LambdaFunctionLayers) GetLabels() []string {
+	return []string{l.functionName, "Layers"}
+}
+
+func (l LambdaFunctionLayers) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (l LambdaFunctionLayers) Render() {
+	model, err := l.repo.GetFunction(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+
+	var data [][]string
+	for _, v := range model.Layers {
+		layerArn := utils.DerefString(v.Arn, "")
+		name, version := utils.ParseLambdaLayerArn(layerArn)
+		data = append(data, []string{
+			name,
+			version,
+			utils.FormatSize(v.CodeSize, 1),
+			layerArn,
+		})
+	}
+	l.SetData(data)
+}
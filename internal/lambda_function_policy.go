@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type LambdaFunctionPolicy struct {
+	*ui.Text
+	view.Lambda
+	repo         *repo.Lambda
+	app          *Application
+	functionName string
+}
+
+func NewLambdaFunctionPolicy(repo *repo.Lambda, functionName string, app *Application) *LambdaFunctionPolicy {
+	l := &LambdaFunctionPolicy{
+		Text:         ui.NewText(true, "json"),
+		repo:         repo,
+		app:          app,
+		functionName: functionName,
+	}
+	return l
+}
+
+func (l LambdaFunctionPolicy) GetLabels() []string {
+	return []string{l.functionName, "Resource Policy"}
+}
+
+func (l LambdaFunctionPolicy) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (l LambdaFunctionPolicy) Render() {
+	policy, err := l.repo.GetPolicy(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+	l.SetText(policy)
+}
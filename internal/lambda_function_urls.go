@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type LambdaFunctionUrls struct {
+	*ui.Table
+	view.Lambda
+	repo         *repo.Lambda
+	app          *Application
+	functionName string
+}
+
+func NewLambdaFunctionUrls(repo *repo.Lambda, functionName string, app *Application) *LambdaFunctionUrls {
+	l := &LambdaFunctionUrls{
+		Table: ui.NewTable([]string{
+			"QUALIFIER",
+			"URL",
+			"AUTH TYPE",
+			"INVOKE MODE",
+			"CORS ORIGINS",
+			"LAST MODIFIED",
+		}, 1, 0),
+		repo:         repo,
+		app:          app,
+		functionName: functionName,
+	}
+	return l
+}
+
+func (l LambdaFunctionUrls) GetLabels() []string {
+	return []string{l.functionName, "Function URLs"}
+}
+
+func (l LambdaFunctionUrls) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (l LambdaFunctionUrls) Render() {
+	model, err := l.repo.ListFunctionUrlConfigs(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+
+	var data [][]string
+	for _, v := range model {
+		// unqualified function arns point at $LATEST
+		qualifier := "$LATEST"
+		if v.FunctionArn != nil {
+			if parts := strings.Split(*v.FunctionArn, ":"); len(parts) == 8 {
+				qualifier = parts[7]
+			}
+		}
+		corsOrigins := "-"
+		if v.Cors != nil && len(v.Cors.AllowOrigins) > 0 {
+			corsOrigins = strings.Join(v.Cors.AllowOrigins, ", ")
+		}
+		data = append(data, []string{
+			qualifier,
+			utils.DerefString(v.FunctionUrl, ""),
+			utils.AutoCase(string(v.AuthType)),
+			utils.AutoCase(string(v.InvokeMode)),
+			corsOrigins,
+			utils.DerefString(v.LastModifiedTime, ""),
+		})
+	}
+	l.SetData(data)
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type LambdaFunctionVersions struct {
+	*ui.Table
+	view.Lambda
+	repo         *repo.Lambda
+	app          *Application
+	functionName string
+}
+
+func NewLambdaFunctionVersions(repo *repo.Lambda, functionName string, app *Application) *LambdaFunctionVersions {
+	l := &LambdaFunctionVersions{
+		Table: ui.NewTable([]string{
+			"VERSION",
+			"RUNTIME",
+			"CODE SIZE",
+			"LAST MODIFIED",
+			"DESCRIPTION",
+		}, 1, 0),
+		repo:         repo,
+		app:          app,
+		functionName: functionName,
+	}
+	return l
+}
+
+func (l LambdaFunctionVersions) GetLabels() []string {
+	return []string{l.functionName, "Versions"}
+}
+
+func (l LambdaFunctionVersions) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (l LambdaFunctionVersions) Render() {
+	model, err := l.repo.ListVersions(l.functionName)
+	if err != nil {
+		panic(err)
+	}
+
+	var data [][]string
+	for _, v := range model {
+		data = append(data, []string{
+			utils.DerefString(v.Version, ""),
+			string(v.Runtime),
+			utils.FormatSize(v.CodeSize, 1),
+			utils.DerefString(v.LastModified, ""),
+			utils.DerefString(v.Description, ""),
+		})
+	}
+	l.SetData(data)
+}
@@ -1,10 +1,12 @@
 package internal
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/bporter816/aws-tui/internal/model"
 	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
 	"github.com/bporter816/aws-tui/internal/view"
@@ -14,12 +16,13 @@ import (
 type LambdaFunctions struct {
 	*ui.Table
 	view.Lambda
-	repo  *repo.Lambda
-	app   *Application
-	model []model.LambdaFunction
+	repo     *repo.Lambda
+	app      *Application
+	model    []model.LambdaFunction
+	settings *settings.Settings
 }
 
-func NewLambdaFunctions(repo *repo.Lambda, app *Application) *LambdaFunctions {
+func NewLambdaFunctions(repo *repo.Lambda, settings *settings.Settings, app *Application) *LambdaFunctions {
 	l := &LambdaFunctions{
 		Table: ui.NewTable([]string{
 			"NAME",
@@ -31,8 +34,9 @@ func NewLambdaFunctions(repo *repo.Lambda, app *Application) *LambdaFunctions {
 			"LAYERS",
 			"DESCRIPTION",
 		}, 1, 0),
-		repo: repo,
-		app:  app,
+		repo:     repo,
+		app:      app,
+		settings: settings,
 	}
 	return l
 }
@@ -41,6 +45,46 @@ func (l LambdaFunctions) GetLabels() []string {
 	return []string{"Functions"}
 }
 
+func (l LambdaFunctions) detailsHandler() {
+	name, err := l.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	detailsView := NewLambdaFunctionDetails(l.repo, name, l.app)
+	l.app.AddAndSwitch(detailsView)
+}
+
+func (l LambdaFunctions) invokeHandler() {
+	name, err := l.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	invokeForm := NewLambdaInvokeForm(l.repo, name, l.settings, l.app)
+	l.app.AddAndSwitch(invokeForm)
+}
+
+func (l LambdaFunctions) downloadCodeHandler() {
+	name, err := l.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	downloadForm := NewLambdaCodeDownloadForm(l.repo, name, l.settings, l.app)
+	l.app.AddAndSwitch(downloadForm)
+}
+
+func (l LambdaFunctions) browseCodeHandler() {
+	name, err := l.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	archiveView := NewLambdaCodeArchive(l.repo, name, l.app)
+	if err := archiveView.load(); err != nil {
+		l.app.ShowError(l.GetService(), fmt.Sprintf("Cannot browse code: %v", err))
+		return
+	}
+	l.app.AddAndSwitch(archiveView)
+}
+
 func (l LambdaFunctions) tagsHandler() {
 	row, err := l.GetRowSelection()
 	if err != nil {
@@ -54,6 +98,26 @@ func (l LambdaFunctions) tagsHandler() {
 
 func (l LambdaFunctions) GetKeyActions() []KeyAction {
 	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'd', tcell.ModNone),
+			Description: "Details",
+			Action:      l.detailsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'i', tcell.ModNone),
+			Description: "Invoke",
+			Action:      l.invokeHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'c', tcell.ModNone),
+			Description: "Browse Code",
+			Action:      l.browseCodeHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'D', tcell.ModNone),
+			Description: "Download Code",
+			Action:      l.downloadCodeHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
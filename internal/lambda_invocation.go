@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type LambdaInvocation struct {
+	*tview.Flex
+	view.Lambda
+	functionName string
+	result       model.LambdaInvocationResult
+	app          *Application
+	status       *tview.TextView
+	response     *ui.Text
+	logs         *ui.Text
+}
+
+func NewLambdaInvocation(functionName string, result model.LambdaInvocationResult, app *Application) *LambdaInvocation {
+	status := tview.NewTextView().SetDynamicColors(true)
+
+	response := ui.NewText(true, "json")
+	response.SetBorder(true)
+	response.SetTitle(" Response ")
+
+	logs := ui.NewText(false, "")
+	logs.SetBorder(true)
+	logs.SetTitle(" Log Tail ")
+
+	panes := tview.NewFlex()
+	panes.AddItem(response, 0, 1, true)
+	panes.AddItem(logs, 0, 1, false)
+
+	flex := tview.NewFlex().SetDirection(tview.FlexRow)
+	flex.AddItem(status, 1, 0, false)
+	flex.AddItem(panes, 0, 1, true)
+
+	l := &LambdaInvocation{
+		Flex:         flex,
+		functionName: functionName,
+		result:       result,
+		app:          app,
+		status:       status,
+		response:     response,
+		logs:         logs,
+	}
+	return l
+}
+
+func (l LambdaInvocation) GetLabels() []string {
+	return []string{l.functionName, "Invocation"}
+}
+
+func (l LambdaInvocation) switchPaneHandler() {
+	if l.response.HasFocus() {
+		l.app.app.SetFocus(l.logs)
+	} else {
+		l.app.app.SetFocus(l.response)
+	}
+}
+
+func (l LambdaInvocation) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone),
+			Description: "Switch Pane",
+			Action:      l.switchPaneHandler,
+		},
+	}
+}
+
+func (l LambdaInvocation) Render() {
+	functionError := "[green]None[-]"
+	if len(l.result.FunctionError) > 0 {
+		functionError = "[red]" + l.result.FunctionError + "[-]"
+	}
+	l.status.SetText(fmt.Sprintf(
+		"Status: %v  Function Error: %v  Executed Version: %v",
+		l.result.StatusCode,
+		functionError,
+		l.result.ExecutedVersion,
+	))
+	l.response.SetText(string(l.result.Payload))
+	l.logs.SetText(l.result.LogResult)
+}
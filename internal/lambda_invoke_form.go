@@ -0,0 +1,190 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	lambdaTypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const lambdaNewPayloadOption = "<new>"
+
+var lambdaInvocationTypes = []lambdaTypes.InvocationType{
+	lambdaTypes.InvocationTypeRequestResponse,
+	lambdaTypes.InvocationTypeEvent,
+}
+
+type LambdaInvokeForm struct {
+	*tview.Form
+	view.Lambda
+	repo         *repo.Lambda
+	functionName string
+	settings     *settings.Settings
+	app          *Application
+}
+
+func NewLambdaInvokeForm(repo *repo.Lambda, functionName string, settings *settings.Settings, app *Application) *LambdaInvokeForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Invoke " + functionName + " ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	l := &LambdaInvokeForm{
+		Form:         form,
+		repo:         repo,
+		functionName: functionName,
+		settings:     settings,
+		app:          app,
+	}
+
+	var invocationTypes []string
+	for _, v := range lambdaInvocationTypes {
+		invocationTypes = append(invocationTypes, string(v))
+	}
+
+	// the selected funcs are set once all items exist, since setting options triggers them
+	form.AddDropDown("Saved Payload", []string{lambdaNewPayloadOption}, 0, nil)
+	form.AddInputField("Payload Name", "", 40, nil, nil)
+	form.AddTextArea("Payload", "{}", 0, 12, 0, nil)
+	form.AddDropDown("Invocation Type", invocationTypes, 0, nil)
+
+	form.AddButton("Invoke", l.invokeHandler)
+	form.AddButton("Save Payload", l.saveHandler)
+	form.AddButton("Delete Payload", l.deleteHandler)
+	form.AddButton("Cancel", l.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	l.setPayloadOptions("")
+	return l
+}
+
+func (l *LambdaInvokeForm) payloadDropDown() *tview.DropDown {
+	return l.GetFormItem(0).(*tview.DropDown)
+}
+
+func (l *LambdaInvokeForm) nameField() *tview.InputField {
+	return l.GetFormItem(1).(*tview.InputField)
+}
+
+func (l *LambdaInvokeForm) payloadArea() *tview.TextArea {
+	return l.GetFormItem(2).(*tview.TextArea)
+}
+
+// setPayloadOptions refreshes the saved payload drop down and selects the given payload
+func (l *LambdaInvokeForm) setPayloadOptions(selected string) {
+	payloads := l.settings.GetLambdaPayloads(l.functionName)
+	var names []string
+	for k := range payloads {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	options := append([]string{lambdaNewPayloadOption}, names...)
+	current := 0
+	for i, v := range options {
+		if v == selected {
+			current = i
+		}
+	}
+	dropDown := l.payloadDropDown()
+	dropDown.SetOptions(options, l.selectPayloadHandler)
+	dropDown.SetCurrentOption(current)
+}
+
+func (l *LambdaInvokeForm) selectPayloadHandler(name string, index int) {
+	if index <= 0 {
+		l.nameField().SetText("")
+		return
+	}
+	if payload, ok := l.settings.GetLambdaPayloads(l.functionName)[name]; ok {
+		l.nameField().SetText(name)
+		l.payloadArea().SetText(payload, false)
+	}
+}
+
+func (l *LambdaInvokeForm) getPayload() (string, error) {
+	payload := strings.TrimSpace(l.payloadArea().GetText())
+	if len(payload) == 0 {
+		payload = "{}"
+	}
+	if !json.Valid([]byte(payload)) {
+		return "", errors.New("Payload is not valid JSON")
+	}
+	return payload, nil
+}
+
+func (l *LambdaInvokeForm) invokeHandler() {
+	payload, err := l.getPayload()
+	if err != nil {
+		l.app.ShowError(l.GetService(), err.Error())
+		return
+	}
+	index, _ := l.GetFormItem(3).(*tview.DropDown).GetCurrentOption()
+	invocationType := lambdaInvocationTypes[index]
+
+	result, err := l.repo.Invoke(l.functionName, []byte(payload), invocationType)
+	if err != nil {
+		l.app.ShowError(l.GetService(), fmt.Sprintf("Invoke failed: %v", err))
+		return
+	}
+	resultView := NewLambdaInvocation(l.functionName, result, l.app)
+	l.app.AddAndSwitch(resultView)
+}
+
+func (l *LambdaInvokeForm) saveHandler() {
+	name := strings.TrimSpace(l.nameField().GetText())
+	if len(name) == 0 || name == lambdaNewPayloadOption {
+		l.app.ShowError(l.GetService(), "Payload name is required")
+		return
+	}
+	payload, err := l.getPayload()
+	if err != nil {
+		l.app.ShowError(l.GetService(), err.Error())
+		return
+	}
+	if err := l.settings.SaveLambdaPayload(l.functionName, name, payload); err != nil {
+		l.app.ShowError(l.GetService(), fmt.Sprintf("Failed to save: %v", err))
+		return
+	}
+	l.setPayloadOptions(name)
+}
+
+func (l *LambdaInvokeForm) deleteHandler() {
+	_, name := l.payloadDropDown().GetCurrentOption()
+	if name == lambdaNewPayloadOption {
+		return
+	}
+	if err := l.settings.DeleteLambdaPayload(l.functionName, name); err != nil {
+		l.app.ShowError(l.GetService(), fmt.Sprintf("Failed to delete: %v", err))
+		return
+	}
+	l.setPayloadOptions("")
+}
+
+func (l *LambdaInvokeForm) cancelHandler() {
+	l.app.Close()
+}
+
+func (l LambdaInvokeForm) GetLabels() []string {
+	return []string{l.functionName, "Invoke"}
+}
+
+func (l LambdaInvokeForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (l LambdaInvokeForm) Render() {
+}
@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"strings"
+)
+
+var maskedValue = strings.Repeat("*", 8)
+
+// maskedValues tracks which sensitive values have been revealed, so they are hidden by default
+// and can be revealed one at a time
+type maskedValues struct {
+	revealed map[string]bool
+}
+
+func newMaskedValues() *maskedValues {
+	return &maskedValues{
+		revealed: make(map[string]bool),
+	}
+}
+
+func (m *maskedValues) Toggle(key string) {
+	m.revealed[key] = !m.revealed[key]
+}
+
+func (m *maskedValues) IsRevealed(key string) bool {
+	return m.revealed[key]
+}
+
+// Format returns the value if it has been revealed, otherwise a mask
+func (m *maskedValues) Format(key string, value string) string {
+	if m.revealed[key] {
+		return value
+	}
+	return maskedValue
+}
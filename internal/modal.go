@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"github.com/rivo/tview"
+)
+
+// ShowError pushes a modal with the given message, closed with OK
+func (a *Application) ShowError(service string, message string) {
+	modal := tview.NewModal().
+		SetText(message).
+		AddButtons([]string{"OK"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			a.Close()
+		})
+	a.AddAndSwitch(&ComponentWrapper{Primitive: modal, service: service, labels: []string{"Error"}})
+}
+
+// Confirm pushes a modal asking the user to confirm an action. onConfirm runs
+// after the modal has been closed, so it is free to push further pages.
+func (a *Application) Confirm(service string, message string, confirmLabel string, onConfirm func()) {
+	modal := tview.NewModal().
+		SetText(message).
+		AddButtons([]string{confirmLabel, "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			a.Close()
+			if buttonLabel == confirmLabel {
+				onConfirm()
+			}
+		})
+	a.AddAndSwitch(&ComponentWrapper{Primitive: modal, service: service, labels: []string{"Confirm"}})
+}
+
+// ShowMessage pushes an informational modal with the given message, closed with OK
+func (a *Application) ShowMessage(service string, message string) {
+	modal := tview.NewModal().
+		SetText(message).
+		AddButtons([]string{"OK"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			a.Close()
+		})
+	a.AddAndSwitch(&ComponentWrapper{Primitive: modal, service: service, labels: []string{"Info"}})
+}
@@ -6,5 +6,6 @@ import (
 
 type (
 	// TODO add details from GetCertificate or DescribeCertificate
-	ACMCertificate acmTypes.CertificateSummary
+	ACMCertificate       acmTypes.CertificateSummary
+	ACMCertificateDetail acmTypes.CertificateDetail
 )
@@ -12,4 +12,5 @@ type (
 	CloudFrontInvalidation                    cfTypes.InvalidationSummary
 	CloudFrontInvalidationPath                string
 	CloudFrontFunction                        cfTypes.FunctionSummary
+	CloudFrontFunctionTestResult              cfTypes.TestResult
 )
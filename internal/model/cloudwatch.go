@@ -6,4 +6,5 @@ import (
 
 type (
 	CloudWatchLogGroup cwLogsTypes.LogGroup
+	CloudWatchLogEvent cwLogsTypes.OutputLogEvent
 )
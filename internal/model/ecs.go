@@ -9,4 +9,5 @@ type (
 	ECSService        ecsTypes.Service
 	ECSTask           ecsTypes.Task
 	ECSTaskDefinition ecsTypes.TaskDefinition
+	ECSContainer      ecsTypes.Container
 )
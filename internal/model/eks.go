@@ -5,5 +5,13 @@ import (
 )
 
 type (
-	EKSCluster eksTypes.Cluster
+	EKSCluster                eksTypes.Cluster
+	EKSNodegroup              eksTypes.Nodegroup
+	EKSFargateProfile         eksTypes.FargateProfile
+	EKSIdentityProviderConfig eksTypes.OidcIdentityProviderConfig
+	EKSAccessEntry            eksTypes.AccessEntry
+	EKSAddon                  struct {
+		eksTypes.Addon
+		AvailableVersions []string // versions compatible with the cluster's Kubernetes version
+	}
 )
@@ -11,14 +11,16 @@ type (
 		ReplicationGroup              *ecTypes.ReplicationGroup
 		ReplicationGroupEngineVersion string
 	}
-	ElastiCacheEvent          ecTypes.Event
-	ElastiCacheReservedNode   ecTypes.ReservedCacheNode
-	ElastiCacheSnapshot       ecTypes.Snapshot
-	ElastiCacheParameterGroup ecTypes.CacheParameterGroup
-	ElastiCacheParameter      ecTypes.Parameter
-	ElastiCacheSubnetGroup    ecTypes.CacheSubnetGroup
-	ElastiCacheUser           ecTypes.User
-	ElastiCacheGroup          ecTypes.UserGroup
-	ElastiCacheServiceUpdate  ecTypes.ServiceUpdate
-	ElastiCacheUpdateAction   ecTypes.UpdateAction
+	ElastiCacheReplicationGroup ecTypes.ReplicationGroup
+	ElastiCacheCacheCluster     ecTypes.CacheCluster
+	ElastiCacheEvent            ecTypes.Event
+	ElastiCacheReservedNode     ecTypes.ReservedCacheNode
+	ElastiCacheSnapshot         ecTypes.Snapshot
+	ElastiCacheParameterGroup   ecTypes.CacheParameterGroup
+	ElastiCacheParameter        ecTypes.Parameter
+	ElastiCacheSubnetGroup      ecTypes.CacheSubnetGroup
+	ElastiCacheUser             ecTypes.User
+	ElastiCacheGroup            ecTypes.UserGroup
+	ElastiCacheServiceUpdate    ecTypes.ServiceUpdate
+	ElastiCacheUpdateAction     ecTypes.UpdateAction
 )
@@ -12,6 +12,7 @@ type (
 	}
 	ELBListenerRule          elbTypes.Rule
 	ELBTargetGroup           elbTypes.TargetGroup
+	ELBTargetHealth          elbTypes.TargetHealthDescription
 	ELBTrustStore            elbTypes.TrustStore
 	ELBTrustStoreAssociation elbTypes.TrustStoreAssociation
 )
@@ -5,5 +5,16 @@ import (
 )
 
 type (
-	LambdaFunction lambdaTypes.FunctionConfiguration
+	LambdaFunction                     lambdaTypes.FunctionConfiguration
+	LambdaAlias                        lambdaTypes.AliasConfiguration
+	LambdaEventSourceMapping           lambdaTypes.EventSourceMappingConfiguration
+	LambdaProvisionedConcurrencyConfig lambdaTypes.ProvisionedConcurrencyConfigListItem
+	LambdaFunctionUrlConfig            lambdaTypes.FunctionUrlConfig
+	LambdaInvocationResult             struct {
+		StatusCode      int32
+		FunctionError   string
+		ExecutedVersion string
+		LogResult       string // decoded, only the last 4 KB are returned
+		Payload         []byte
+	}
 )
@@ -13,6 +13,15 @@ type (
 	RDSParameter              rdsTypes.Parameter
 	RDSSubnetGroup            rdsTypes.DBSubnetGroup
 	RDSReservedInstance       rdsTypes.ReservedDBInstance
+	RDSInstanceSnapshot       rdsTypes.DBSnapshot
+	RDSClusterSnapshot        rdsTypes.DBClusterSnapshot
+	RDSEvent                  rdsTypes.Event
+	RDSLogFile                rdsTypes.DescribeDBLogFilesDetails
+	RDSLogFilePortion         struct {
+		Data                  string
+		Marker                string
+		AdditionalDataPending bool
+	}
 )
 
 func (r RDSClusterParameterGroup) Arn() string {
@@ -23,6 +32,14 @@ func (r RDSInstanceParameterGroup) Arn() string {
 	return *r.DBParameterGroupArn
 }
 
+func (r RDSInstanceSnapshot) Arn() string {
+	return *r.DBSnapshotArn
+}
+
+func (r RDSClusterSnapshot) Arn() string {
+	return *r.DBClusterSnapshotArn
+}
+
 type RDSParameterGroupType string
 
 const RDSParameterGroupTypeCluster RDSParameterGroupType = "Cluster"
@@ -1,11 +1,20 @@
 package model
 
 import (
+	r53 "github.com/aws/aws-sdk-go-v2/service/route53"
 	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 )
 
 type (
-	Route53HostedZone  r53Types.HostedZone
-	Route53Record      r53Types.ResourceRecordSet
-	Route53HealthCheck r53Types.HealthCheck
+	Route53HostedZone             r53Types.HostedZone
+	Route53Record                 r53Types.ResourceRecordSet
+	Route53HealthCheck            r53Types.HealthCheck
+	Route53ChangeInfo             r53Types.ChangeInfo
+	Route53DNSAnswer              r53.TestDNSAnswerOutput
+	Route53HealthCheckObservation r53Types.HealthCheckObservation
+	// Route53ZoneRecord is a record along with the hosted zone it belongs to
+	Route53ZoneRecord struct {
+		HostedZone Route53HostedZone
+		Record     Route53Record
+	}
 )
@@ -1,7 +1,12 @@
 package model
 
 import (
+	sm "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	smTypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
 )
 
-type SecretsManagerSecret smTypes.SecretListEntry
+type (
+	SecretsManagerSecret        smTypes.SecretListEntry
+	SecretsManagerSecretVersion smTypes.SecretVersionsListEntry
+	SecretsManagerSecretDetails sm.DescribeSecretOutput
+)
@@ -1,8 +1,25 @@
 package model
 
+import (
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
 // SQS returns a map of attributes rather than a struct
 type SQSQueue struct {
-	Name     string
-	QueueUrl string
-	IsFifo   bool
+	Name                     string
+	QueueUrl                 string
+	Arn                      string
+	IsFifo                   bool
+	MessagesAvailable        int64
+	MessagesInFlight         int64
+	MessagesDelayed          int64
+	VisibilityTimeoutSeconds int64
+	RetentionPeriodSeconds   int64
+	DeadLetterTargetArn      string
+	MaxReceiveCount          int64
 }
+
+type (
+	SQSMessage         sqsTypes.Message
+	SQSMessageMoveTask sqsTypes.ListMessageMoveTasksResultEntry
+)
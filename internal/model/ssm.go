@@ -5,5 +5,7 @@ import (
 )
 
 type (
-	SSMParameter ssmTypes.ParameterMetadata
+	SSMParameter        ssmTypes.ParameterMetadata
+	SSMParameterValue   ssmTypes.Parameter
+	SSMParameterHistory ssmTypes.ParameterHistory
 )
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// NewParameterGroupDiffForm asks which parameter group to compare groupName against. options[0] is
+// expected to be the engine defaults for the group's family.
+func NewParameterGroupDiffForm(app *Application, service string, groupName string, options []string, onCompare func(index int, option string)) Component {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Compare Parameter Groups ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	form.AddTextView("Parameter Group", groupName, 0, 1, false, false)
+	form.AddDropDown("Compare With", options, 0, nil)
+	form.AddButton("Compare", func() {
+		index, option := form.GetFormItem(1).(*tview.DropDown).GetCurrentOption()
+		app.Close()
+		onCompare(index, option)
+	})
+	form.AddButton("Cancel", func() {
+		app.Close()
+	})
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return &ComponentWrapper{Primitive: form, service: service, labels: []string{groupName, "Compare"}}
+}
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"fmt"
 	"strconv"
 
 	rdsTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
@@ -58,6 +59,63 @@ func (r RDSClusters) endpointsHandler() {
 	r.app.AddAndSwitch(endpointsView)
 }
 
+func (r *RDSClusters) failoverHandler() {
+	row, err := r.GetRowSelection()
+	if err != nil {
+		return
+	}
+	failoverForm := NewRDSFailoverClusterForm(r.repo, r.model[row-1], r.app, func() {
+		r.Render()
+	})
+	r.app.AddAndSwitch(failoverForm)
+}
+
+func (r *RDSClusters) stopHandler() {
+	clusterId, err := r.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	r.app.Confirm(r.GetService(), "Stop cluster "+clusterId+"? Stopped clusters are started again automatically after 7 days.", "Stop", func() {
+		if err := r.repo.StopCluster(clusterId); err != nil {
+			r.app.ShowError(r.GetService(), fmt.Sprintf("Stop cluster failed: %v", err))
+			return
+		}
+		r.Render()
+	})
+}
+
+func (r *RDSClusters) startHandler() {
+	clusterId, err := r.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	r.app.Confirm(r.GetService(), "Start cluster "+clusterId+"?", "Start", func() {
+		if err := r.repo.StartCluster(clusterId); err != nil {
+			r.app.ShowError(r.GetService(), fmt.Sprintf("Start cluster failed: %v", err))
+			return
+		}
+		r.Render()
+	})
+}
+
+func (r RDSClusters) snapshotHandler() {
+	clusterId, err := r.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	snapshotForm := NewRDSCreateSnapshotForm(r.repo, rdsTypes.SourceTypeDbCluster, clusterId, r.app)
+	r.app.AddAndSwitch(snapshotForm)
+}
+
+func (r RDSClusters) eventsHandler() {
+	clusterId, err := r.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	eventsView := NewRDSEvents(r.repo, rdsTypes.SourceTypeDbCluster, clusterId, r.app)
+	r.app.AddAndSwitch(eventsView)
+}
+
 func (r RDSClusters) tagsHandler() {
 	row, err := r.GetRowSelection()
 	if err != nil || r.model[row-1].DBClusterArn == nil {
@@ -67,7 +125,7 @@ func (r RDSClusters) tagsHandler() {
 	r.app.AddAndSwitch(tagsView)
 }
 
-func (r RDSClusters) GetKeyActions() []KeyAction {
+func (r *RDSClusters) GetKeyActions() []KeyAction {
 	return []KeyAction{
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'i', tcell.ModNone),
@@ -79,6 +137,31 @@ func (r RDSClusters) GetKeyActions() []KeyAction {
 			Description: "Endpoints",
 			Action:      r.endpointsHandler,
 		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'f', tcell.ModNone),
+			Description: "Failover",
+			Action:      r.failoverHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone),
+			Description: "Stop",
+			Action:      r.stopHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'S', tcell.ModNone),
+			Description: "Start",
+			Action:      r.startHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'n', tcell.ModNone),
+			Description: "Create Snapshot",
+			Action:      r.snapshotHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'E', tcell.ModNone),
+			Description: "Events",
+			Action:      r.eventsHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
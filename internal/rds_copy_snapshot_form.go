@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type RDSCopySnapshotForm struct {
+	*tview.Form
+	view.RDS
+	repo       *repo.RDS
+	snapshot   model.ModelWithArn
+	snapshotId string
+	app        *Application
+	onComplete func()
+}
+
+// NewRDSCopySnapshotForm copies a snapshot, optionally to another region or re-encrypted with another KMS key
+func NewRDSCopySnapshotForm(repo *repo.RDS, snapshot model.ModelWithArn, snapshotId string, app *Application, onComplete func()) *RDSCopySnapshotForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Copy Snapshot ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	r := &RDSCopySnapshotForm{
+		Form:       form,
+		repo:       repo,
+		snapshot:   snapshot,
+		snapshotId: snapshotId,
+		app:        app,
+		onComplete: onComplete,
+	}
+
+	// shared snapshots are named by their ARN and automated ones contain a colon, neither of which is a valid identifier
+	targetId := snapshotId
+	if strings.HasPrefix(targetId, "arn:") {
+		targetId = targetId[strings.LastIndex(targetId, ":")+1:]
+	}
+	targetId = strings.ReplaceAll(targetId, ":", "-")
+
+	form.AddTextView("Source", snapshotId, 0, 1, false, false)
+	form.AddInputField("Target Identifier", targetId+"-copy", 0, nil, nil)
+	form.AddInputField("Destination Region", app.region, 0, nil, nil)
+	form.AddInputField("KMS Key", "", 0, nil, nil)
+	form.AddCheckbox("Copy Tags", true, nil)
+	form.AddTextView("", "Leave KMS Key empty to keep the source key. Encrypted copies to another region need a key in that region.", 0, 2, false, false)
+	form.AddButton("Copy", r.copyHandler)
+	form.AddButton("Cancel", r.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return r
+}
+
+func (r *RDSCopySnapshotForm) copyHandler() {
+	targetId := strings.TrimSpace(r.GetFormItem(1).(*tview.InputField).GetText())
+	destRegion := strings.TrimSpace(r.GetFormItem(2).(*tview.InputField).GetText())
+	kmsKeyId := strings.TrimSpace(r.GetFormItem(3).(*tview.InputField).GetText())
+	copyTags := r.GetFormItem(4).(*tview.Checkbox).IsChecked()
+	if len(targetId) == 0 {
+		r.app.ShowError(r.GetService(), "Target identifier is required")
+		return
+	}
+
+	var err error
+	if _, ok := r.snapshot.(model.RDSClusterSnapshot); ok {
+		err = r.repo.CopyClusterSnapshot(r.snapshot.Arn(), targetId, destRegion, kmsKeyId, copyTags)
+	} else {
+		err = r.repo.CopyInstanceSnapshot(r.snapshot.Arn(), targetId, destRegion, kmsKeyId, copyTags)
+	}
+	if err != nil {
+		r.app.ShowError(r.GetService(), fmt.Sprintf("Copy snapshot failed: %v", err))
+		return
+	}
+
+	r.app.Close()
+	if r.onComplete != nil {
+		r.onComplete()
+	}
+	if destRegion != "" && destRegion != r.app.region {
+		r.app.ShowMessage(r.GetService(), "Copying snapshot to "+targetId+" in "+destRegion)
+	}
+}
+
+func (r *RDSCopySnapshotForm) cancelHandler() {
+	r.app.Close()
+}
+
+func (r RDSCopySnapshotForm) GetLabels() []string {
+	return []string{r.snapshotId, "Copy"}
+}
+
+func (r RDSCopySnapshotForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (r RDSCopySnapshotForm) Render() {
+}
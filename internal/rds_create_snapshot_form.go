@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	rdsTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type RDSCreateSnapshotForm struct {
+	*tview.Form
+	view.RDS
+	repo       *repo.RDS
+	sourceType rdsTypes.SourceType
+	sourceId   string
+	app        *Application
+}
+
+// NewRDSCreateSnapshotForm creates a manual snapshot of an instance or a cluster
+func NewRDSCreateSnapshotForm(repo *repo.RDS, sourceType rdsTypes.SourceType, sourceId string, app *Application) *RDSCreateSnapshotForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Create Snapshot ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	r := &RDSCreateSnapshotForm{
+		Form:       form,
+		repo:       repo,
+		sourceType: sourceType,
+		sourceId:   sourceId,
+		app:        app,
+	}
+
+	form.AddTextView("Source", sourceId, 0, 1, false, false)
+	form.AddInputField("Snapshot Identifier", sourceId+"-"+time.Now().Format("2006-01-02-15-04"), 0, nil, nil)
+	form.AddButton("Create", r.createHandler)
+	form.AddButton("Cancel", r.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return r
+}
+
+func (r *RDSCreateSnapshotForm) createHandler() {
+	snapshotId := strings.TrimSpace(r.GetFormItem(1).(*tview.InputField).GetText())
+	if len(snapshotId) == 0 {
+		r.app.ShowError(r.GetService(), "Snapshot identifier is required")
+		return
+	}
+
+	var err error
+	if r.sourceType == rdsTypes.SourceTypeDbCluster {
+		err = r.repo.CreateClusterSnapshot(r.sourceId, snapshotId)
+	} else {
+		err = r.repo.CreateInstanceSnapshot(r.sourceId, snapshotId)
+	}
+	if err != nil {
+		r.app.ShowError(r.GetService(), fmt.Sprintf("Create snapshot failed: %v", err))
+		return
+	}
+
+	r.app.Close()
+	r.app.ShowMessage(r.GetService(), "Creating snapshot "+snapshotId)
+}
+
+func (r *RDSCreateSnapshotForm) cancelHandler() {
+	r.app.Close()
+}
+
+func (r RDSCreateSnapshotForm) GetLabels() []string {
+	return []string{r.sourceId, "Create Snapshot"}
+}
+
+func (r RDSCreateSnapshotForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (r RDSCreateSnapshotForm) Render() {
+}
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"sort"
+	"strings"
+
+	rdsTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type RDSEvents struct {
+	*ui.Table
+	view.RDS
+	repo       *repo.RDS
+	sourceType rdsTypes.SourceType
+	sourceId   string
+	app        *Application
+}
+
+func NewRDSEvents(repo *repo.RDS, sourceType rdsTypes.SourceType, sourceId string, app *Application) *RDSEvents {
+	r := &RDSEvents{
+		Table: ui.NewTable([]string{
+			"TIME",
+			"CATEGORIES",
+			"MESSAGE",
+		}, 1, 0),
+		repo:       repo,
+		sourceType: sourceType,
+		sourceId:   sourceId,
+		app:        app,
+	}
+	return r
+}
+
+func (r RDSEvents) GetLabels() []string {
+	return []string{r.sourceId, "Events"}
+}
+
+func (r RDSEvents) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (r RDSEvents) Render() {
+	model, err := r.repo.ListEvents(r.sourceType, r.sourceId)
+	if err != nil {
+		panic(err)
+	}
+	// newest first
+	sort.SliceStable(model, func(i, j int) bool {
+		if model[i].Date == nil || model[j].Date == nil {
+			return model[i].Date != nil
+		}
+		return model[i].Date.After(*model[j].Date)
+	})
+
+	var data [][]string
+	for _, v := range model {
+		var date string
+		if v.Date != nil {
+			date = v.Date.Format(utils.DefaultTimeFormat)
+		}
+		data = append(data, []string{
+			date,
+			strings.Join(v.EventCategories, ", "),
+			utils.DerefString(v.Message, ""),
+		})
+	}
+	r.SetData(data)
+}
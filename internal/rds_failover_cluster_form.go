@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const rdsAnyReaderOption = "<any reader>"
+
+type RDSFailoverClusterForm struct {
+	*tview.Form
+	view.RDS
+	repo       *repo.RDS
+	clusterId  string
+	app        *Application
+	onComplete func()
+}
+
+func NewRDSFailoverClusterForm(repo *repo.RDS, cluster model.RDSCluster, app *Application, onComplete func()) *RDSFailoverClusterForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Failover Cluster ")
+	form.SetTitleColor(tcell.ColorRed)
+
+	r := &RDSFailoverClusterForm{
+		Form:       form,
+		repo:       repo,
+		clusterId:  utils.DerefString(cluster.DBClusterIdentifier, ""),
+		app:        app,
+		onComplete: onComplete,
+	}
+
+	var writer string
+	targets := []string{rdsAnyReaderOption}
+	for _, v := range cluster.DBClusterMembers {
+		id := utils.DerefString(v.DBInstanceIdentifier, "")
+		if v.IsClusterWriter != nil && *v.IsClusterWriter {
+			writer = id
+		} else {
+			targets = append(targets, id)
+		}
+	}
+
+	form.AddTextView("Cluster", r.clusterId, 0, 1, false, false)
+	form.AddTextView("Current Writer", writer, 0, 1, false, false)
+	form.AddDropDown("New Writer", targets, 0, nil)
+	form.AddButton("Failover", r.failoverHandler)
+	form.AddButton("Cancel", r.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorRed)
+	form.SetButtonTextColor(tcell.ColorWhite)
+
+	return r
+}
+
+func (r *RDSFailoverClusterForm) failoverHandler() {
+	_, target := r.GetFormItem(2).(*tview.DropDown).GetCurrentOption()
+	if target == rdsAnyReaderOption {
+		target = ""
+	}
+	if err := r.repo.FailoverCluster(r.clusterId, target); err != nil {
+		r.app.ShowError(r.GetService(), fmt.Sprintf("Failover failed: %v", err))
+		return
+	}
+
+	r.app.Close()
+	if r.onComplete != nil {
+		r.onComplete()
+	}
+}
+
+func (r *RDSFailoverClusterForm) cancelHandler() {
+	r.app.Close()
+}
+
+func (r RDSFailoverClusterForm) GetLabels() []string {
+	return []string{r.clusterId, "Failover"}
+}
+
+func (r RDSFailoverClusterForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (r RDSFailoverClusterForm) Render() {
+}
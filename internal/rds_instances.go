@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	rdsTypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/bporter816/aws-tui/internal/model"
@@ -37,9 +38,78 @@ func NewRDSInstances(repo *repo.RDS, app *Application, dbClusterId string) *RDSI
 }
 
 func (r RDSInstances) GetLabels() []string {
+	if r.dbClusterId == "" {
+		return []string{"Instances"}
+	}
 	return []string{r.dbClusterId, "Instances"}
 }
 
+func (r *RDSInstances) rebootHandler() {
+	row, err := r.GetRowSelection()
+	if err != nil {
+		return
+	}
+	rebootForm := NewRDSRebootInstanceForm(r.repo, r.model[row-1], r.app, func() {
+		r.Render()
+	})
+	r.app.AddAndSwitch(rebootForm)
+}
+
+func (r *RDSInstances) stopHandler() {
+	instanceId, err := r.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	r.app.Confirm(r.GetService(), "Stop instance "+instanceId+"? Stopped instances are started again automatically after 7 days.", "Stop", func() {
+		if err := r.repo.StopInstance(instanceId); err != nil {
+			r.app.ShowError(r.GetService(), fmt.Sprintf("Stop instance failed: %v", err))
+			return
+		}
+		r.Render()
+	})
+}
+
+func (r *RDSInstances) startHandler() {
+	instanceId, err := r.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	r.app.Confirm(r.GetService(), "Start instance "+instanceId+"?", "Start", func() {
+		if err := r.repo.StartInstance(instanceId); err != nil {
+			r.app.ShowError(r.GetService(), fmt.Sprintf("Start instance failed: %v", err))
+			return
+		}
+		r.Render()
+	})
+}
+
+func (r *RDSInstances) snapshotHandler() {
+	instanceId, err := r.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	snapshotForm := NewRDSCreateSnapshotForm(r.repo, rdsTypes.SourceTypeDbInstance, instanceId, r.app)
+	r.app.AddAndSwitch(snapshotForm)
+}
+
+func (r RDSInstances) eventsHandler() {
+	instanceId, err := r.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	eventsView := NewRDSEvents(r.repo, rdsTypes.SourceTypeDbInstance, instanceId, r.app)
+	r.app.AddAndSwitch(eventsView)
+}
+
+func (r RDSInstances) logFilesHandler() {
+	instanceId, err := r.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	logFilesView := NewRDSLogFiles(r.repo, instanceId, r.app)
+	r.app.AddAndSwitch(logFilesView)
+}
+
 func (r RDSInstances) tagsHandler() {
 	row, err := r.GetRowSelection()
 	if err != nil || r.model[row-1].DBInstanceArn == nil {
@@ -49,8 +119,38 @@ func (r RDSInstances) tagsHandler() {
 	r.app.AddAndSwitch(tagsView)
 }
 
-func (r RDSInstances) GetKeyActions() []KeyAction {
+func (r *RDSInstances) GetKeyActions() []KeyAction {
 	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'b', tcell.ModNone),
+			Description: "Reboot",
+			Action:      r.rebootHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone),
+			Description: "Stop",
+			Action:      r.stopHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'S', tcell.ModNone),
+			Description: "Start",
+			Action:      r.startHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'n', tcell.ModNone),
+			Description: "Create Snapshot",
+			Action:      r.snapshotHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'E', tcell.ModNone),
+			Description: "Events",
+			Action:      r.eventsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'l', tcell.ModNone),
+			Description: "Log Files",
+			Action:      r.logFilesHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
@@ -60,12 +160,14 @@ func (r RDSInstances) GetKeyActions() []KeyAction {
 }
 
 func (r *RDSInstances) Render() {
-	model, err := r.repo.ListInstances([]rdsTypes.Filter{
-		{
+	filters := []rdsTypes.Filter{}
+	if r.dbClusterId != "" {
+		filters = append(filters, rdsTypes.Filter{
 			Name:   aws.String("db-cluster-id"),
 			Values: []string{r.dbClusterId},
-		},
-	})
+		})
+	}
+	model, err := r.repo.ListInstances(filters)
 	if err != nil {
 		panic(err)
 	}
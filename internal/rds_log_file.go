@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+const rdsLogFileTailLines = 1000
+
+type RDSLogFile struct {
+	*ui.Text
+	view.RDS
+	repo        *repo.RDS
+	instanceId  string
+	logFileName string
+	app         *Application
+	data        string
+	marker      string
+	following   bool
+	refreshing  bool
+}
+
+func NewRDSLogFile(repo *repo.RDS, instanceId string, logFileName string, app *Application) *RDSLogFile {
+	r := &RDSLogFile{
+		Text:        ui.NewText(false, ""),
+		repo:        repo,
+		instanceId:  instanceId,
+		logFileName: logFileName,
+		app:         app,
+	}
+	return r
+}
+
+func (r RDSLogFile) GetLabels() []string {
+	return []string{r.instanceId, r.logFileName}
+}
+
+// followHandler toggles polling for lines written after the ones already shown
+func (r *RDSLogFile) followHandler() {
+	r.following = !r.following
+	// toggling back on before the pending refresh fires keeps the existing chain going
+	if !r.refreshing && r.following {
+		r.refreshing = true
+		r.app.AutoRefresh(r, 5*time.Second, func() bool {
+			r.refreshing = r.following
+			return r.refreshing
+		})
+	}
+}
+
+func (r *RDSLogFile) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'f', tcell.ModNone),
+			Description: "Follow",
+			Action:      r.followHandler,
+		},
+	}
+}
+
+func (r *RDSLogFile) Render() {
+	portion, err := r.repo.DownloadLogFilePortion(r.instanceId, r.logFileName, r.marker, rdsLogFileTailLines)
+	if err != nil {
+		panic(err)
+	}
+	r.data += portion.Data
+	// keep the old marker if nothing new was written
+	if portion.Marker != "" {
+		r.marker = portion.Marker
+	}
+	r.SetText(r.data)
+	r.ScrollToEnd()
+}
@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type RDSLogFiles struct {
+	*ui.Table
+	view.RDS
+	repo       *repo.RDS
+	instanceId string
+	app        *Application
+}
+
+func NewRDSLogFiles(repo *repo.RDS, instanceId string, app *Application) *RDSLogFiles {
+	r := &RDSLogFiles{
+		Table: ui.NewTable([]string{
+			"NAME",
+			"SIZE",
+			"LAST WRITTEN",
+		}, 1, 0),
+		repo:       repo,
+		instanceId: instanceId,
+		app:        app,
+	}
+	return r
+}
+
+func (r RDSLogFiles) GetLabels() []string {
+	return []string{r.instanceId, "Log Files"}
+}
+
+func (r RDSLogFiles) logFileHandler() {
+	name, err := r.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	logFileView := NewRDSLogFile(r.repo, r.instanceId, name, r.app)
+	r.app.AddAndSwitch(logFileView)
+}
+
+func (r RDSLogFiles) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone),
+			Description: "View Log File",
+			Action:      r.logFileHandler,
+		},
+	}
+}
+
+func (r RDSLogFiles) Render() {
+	model, err := r.repo.ListLogFiles(r.instanceId)
+	if err != nil {
+		panic(err)
+	}
+	// most recently written first, since those are the ones worth tailing
+	sort.SliceStable(model, func(i, j int) bool {
+		if model[i].LastWritten == nil || model[j].LastWritten == nil {
+			return model[i].LastWritten != nil
+		}
+		return *model[i].LastWritten > *model[j].LastWritten
+	})
+
+	var data [][]string
+	for _, v := range model {
+		var size, lastWritten string
+		if v.Size != nil {
+			size = utils.FormatSize(*v.Size, 1)
+		}
+		if v.LastWritten != nil {
+			lastWritten = time.UnixMilli(*v.LastWritten).Format(utils.DefaultTimeFormat)
+		}
+		data = append(data, []string{
+			utils.DerefString(v.LogFileName, ""),
+			size,
+			lastWritten,
+		})
+	}
+	r.SetData(data)
+}
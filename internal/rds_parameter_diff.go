@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"errors"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type RDSParameterDiff struct {
+	*ui.Table
+	view.RDS
+	repo      *repo.RDS
+	app       *Application
+	groupType model.RDSParameterGroupType
+	family    string
+	leftName  string
+	rightName string
+}
+
+// NewRDSParameterDiff compares two parameter groups of the same type side by side. An empty rightName
+// compares against the engine defaults for the family instead.
+func NewRDSParameterDiff(repo *repo.RDS, app *Application, groupType model.RDSParameterGroupType, family string, leftName string, rightName string) *RDSParameterDiff {
+	rightHeader := "ENGINE DEFAULT"
+	if rightName != "" {
+		rightHeader = utils.UpperCase(rightName)
+	}
+	r := &RDSParameterDiff{
+		Table: ui.NewTable([]string{
+			"NAME",
+			utils.UpperCase(leftName),
+			rightHeader,
+		}, 1, 1),
+		repo:      repo,
+		app:       app,
+		groupType: groupType,
+		family:    family,
+		leftName:  leftName,
+		rightName: rightName,
+	}
+	return r
+}
+
+func (r RDSParameterDiff) GetLabels() []string {
+	rightLabel := "Engine Defaults"
+	if r.rightName != "" {
+		rightLabel = r.rightName
+	}
+	return []string{r.leftName, "Diff", rightLabel}
+}
+
+func (r RDSParameterDiff) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (r RDSParameterDiff) listParameters(groupName string) (map[string]string, error) {
+	var parameters []model.RDSParameter
+	var err error
+	switch {
+	case r.groupType == model.RDSParameterGroupTypeCluster && groupName == "":
+		parameters, err = r.repo.ListEngineDefaultClusterParameters(r.family)
+	case r.groupType == model.RDSParameterGroupTypeCluster:
+		parameters, err = r.repo.ListClusterParameters(groupName)
+	case r.groupType == model.RDSParameterGroupTypeInstance && groupName == "":
+		parameters, err = r.repo.ListEngineDefaultParameters(r.family)
+	case r.groupType == model.RDSParameterGroupTypeInstance:
+		parameters, err = r.repo.ListInstanceParameters(groupName)
+	default:
+		err = errors.New("param group type must be cluster or instance")
+	}
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string)
+	for _, v := range parameters {
+		values[utils.DerefString(v.ParameterName, "")] = utils.DerefString(v.ParameterValue, "")
+	}
+	return values, nil
+}
+
+func (r RDSParameterDiff) Render() {
+	left, err := r.listParameters(r.leftName)
+	if err != nil {
+		panic(err)
+	}
+	right, err := r.listParameters(r.rightName)
+	if err != nil {
+		panic(err)
+	}
+
+	var data [][]string
+	for _, name := range utils.DiffStringMaps(left, right) {
+		data = append(data, []string{
+			name,
+			left[name],
+			right[name],
+		})
+	}
+	r.SetData(data)
+}
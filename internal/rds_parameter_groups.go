@@ -54,6 +54,45 @@ func (r RDSParameterGroups) parametersHandler() {
 	r.app.AddAndSwitch(parametersView)
 }
 
+func (r RDSParameterGroups) diffHandler() {
+	row, err := r.GetRowSelection()
+	if err != nil {
+		return
+	}
+	name, _ := r.GetColSelection("NAME")
+	family, _ := r.GetColSelection("FAMILY")
+
+	// only groups of the same type have comparable parameters
+	var groupType model.RDSParameterGroupType
+	options := []string{"Engine Defaults (" + family + ")"}
+	switch r.model[row-1].(type) {
+	case model.RDSClusterParameterGroup:
+		groupType = model.RDSParameterGroupTypeCluster
+		for _, v := range r.model {
+			if g, ok := v.(model.RDSClusterParameterGroup); ok && utils.DerefString(g.DBClusterParameterGroupName, "") != name {
+				options = append(options, *g.DBClusterParameterGroupName)
+			}
+		}
+	case model.RDSInstanceParameterGroup:
+		groupType = model.RDSParameterGroupTypeInstance
+		for _, v := range r.model {
+			if g, ok := v.(model.RDSInstanceParameterGroup); ok && utils.DerefString(g.DBParameterGroupName, "") != name {
+				options = append(options, *g.DBParameterGroupName)
+			}
+		}
+	}
+
+	diffForm := NewParameterGroupDiffForm(r.app, r.GetService(), name, options, func(index int, option string) {
+		var rightName string
+		if index > 0 {
+			rightName = option
+		}
+		diffView := NewRDSParameterDiff(r.repo, r.app, groupType, family, name, rightName)
+		r.app.AddAndSwitch(diffView)
+	})
+	r.app.AddAndSwitch(diffForm)
+}
+
 func (r RDSParameterGroups) tagsHandler() {
 	row, err := r.GetRowSelection()
 	if err != nil {
@@ -70,6 +109,11 @@ func (r RDSParameterGroups) GetKeyActions() []KeyAction {
 			Description: "Parameters",
 			Action:      r.parametersHandler,
 		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'd', tcell.ModNone),
+			Description: "Diff",
+			Action:      r.diffHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
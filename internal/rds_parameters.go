@@ -8,15 +8,17 @@ import (
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
 	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
 )
 
 type RDSParameters struct {
 	*ui.Table
 	view.RDS
-	repo      *repo.RDS
-	app       *Application
-	groupName string
-	groupType model.RDSParameterGroupType
+	repo         *repo.RDS
+	app          *Application
+	groupName    string
+	groupType    model.RDSParameterGroupType
+	modifiedOnly bool
 }
 
 func NewRDSParameters(repo *repo.RDS, app *Application, groupName string, groupType model.RDSParameterGroupType) *RDSParameters {
@@ -28,6 +30,7 @@ func NewRDSParameters(repo *repo.RDS, app *Application, groupName string, groupT
 			"APPLY METHOD",
 			"APPLY TYPE",
 			"MODIFIABLE",
+			"SOURCE",
 			"DESCRIPTION",
 		}, 1, 1),
 		repo:      repo,
@@ -39,14 +42,28 @@ func NewRDSParameters(repo *repo.RDS, app *Application, groupName string, groupT
 }
 
 func (r RDSParameters) GetLabels() []string {
+	if r.modifiedOnly {
+		return []string{r.groupName, "Parameters", "Modified"}
+	}
 	return []string{r.groupName, "Parameters"}
 }
 
-func (r RDSParameters) GetKeyActions() []KeyAction {
-	return []KeyAction{}
+func (r *RDSParameters) modifiedOnlyHandler() {
+	r.modifiedOnly = !r.modifiedOnly
+	r.Render()
 }
 
-func (r RDSParameters) Render() {
+func (r *RDSParameters) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'm', tcell.ModNone),
+			Description: "Toggle Modified Only",
+			Action:      r.modifiedOnlyHandler,
+		},
+	}
+}
+
+func (r *RDSParameters) Render() {
 	var parameters []model.RDSParameter
 	var err error
 	if r.groupType == model.RDSParameterGroupTypeCluster {
@@ -62,6 +79,10 @@ func (r RDSParameters) Render() {
 
 	var data [][]string
 	for _, v := range parameters {
+		// parameters set in the group have a source of user, the rest come from the engine defaults or the system
+		if r.modifiedOnly && utils.DerefString(v.Source, "") != "user" {
+			continue
+		}
 		var modifiable string
 		if v.IsModifiable != nil {
 			modifiable = utils.BoolToString(*v.IsModifiable, "Yes", "No")
@@ -73,6 +94,7 @@ func (r RDSParameters) Render() {
 			string(v.ApplyMethod),
 			utils.DerefString(v.ApplyType, ""),
 			modifiable,
+			utils.DerefString(v.Source, ""),
 			utils.DerefString(v.Description, ""),
 		})
 	}
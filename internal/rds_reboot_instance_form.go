@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type RDSRebootInstanceForm struct {
+	*tview.Form
+	view.RDS
+	repo       *repo.RDS
+	instanceId string
+	multiAZ    bool
+	app        *Application
+	onComplete func()
+}
+
+func NewRDSRebootInstanceForm(repo *repo.RDS, instance model.RDSInstance, app *Application, onComplete func()) *RDSRebootInstanceForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Reboot Instance ")
+	form.SetTitleColor(tcell.ColorRed)
+
+	r := &RDSRebootInstanceForm{
+		Form:       form,
+		repo:       repo,
+		instanceId: utils.DerefString(instance.DBInstanceIdentifier, ""),
+		multiAZ:    instance.MultiAZ != nil && *instance.MultiAZ,
+		app:        app,
+		onComplete: onComplete,
+	}
+
+	form.AddTextView("Instance", r.instanceId, 0, 1, false, false)
+	// failover is only possible for Multi-AZ instances
+	if r.multiAZ {
+		form.AddCheckbox("Reboot With Failover", false, nil)
+	}
+	form.AddButton("Reboot", r.rebootHandler)
+	form.AddButton("Cancel", r.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorRed)
+	form.SetButtonTextColor(tcell.ColorWhite)
+
+	return r
+}
+
+func (r *RDSRebootInstanceForm) rebootHandler() {
+	forceFailover := r.multiAZ && r.GetFormItem(1).(*tview.Checkbox).IsChecked()
+	if err := r.repo.RebootInstance(r.instanceId, forceFailover); err != nil {
+		r.app.ShowError(r.GetService(), fmt.Sprintf("Reboot failed: %v", err))
+		return
+	}
+
+	r.app.Close()
+	if r.onComplete != nil {
+		r.onComplete()
+	}
+}
+
+func (r *RDSRebootInstanceForm) cancelHandler() {
+	r.app.Close()
+}
+
+func (r RDSRebootInstanceForm) GetLabels() []string {
+	return []string{r.instanceId, "Reboot"}
+}
+
+func (r RDSRebootInstanceForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (r RDSRebootInstanceForm) Render() {
+}
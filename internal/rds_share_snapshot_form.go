@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type RDSShareSnapshotForm struct {
+	*tview.Form
+	view.RDS
+	repo       *repo.RDS
+	snapshot   model.ModelWithArn
+	snapshotId string
+	app        *Application
+}
+
+func NewRDSShareSnapshotForm(repo *repo.RDS, snapshot model.ModelWithArn, snapshotId string, app *Application) *RDSShareSnapshotForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Share Snapshot ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	r := &RDSShareSnapshotForm{
+		Form:       form,
+		repo:       repo,
+		snapshot:   snapshot,
+		snapshotId: snapshotId,
+		app:        app,
+	}
+
+	form.AddTextView("Snapshot", snapshotId, 0, 1, false, false)
+	form.AddInputField("Account ID", "", 12, func(textToCheck string, lastChar rune) bool {
+		return lastChar >= '0' && lastChar <= '9'
+	}, nil)
+	form.AddButton("Share", r.shareHandler)
+	form.AddButton("Cancel", r.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return r
+}
+
+func (r *RDSShareSnapshotForm) shareHandler() {
+	accountId := strings.TrimSpace(r.GetFormItem(1).(*tview.InputField).GetText())
+	if len(accountId) != 12 {
+		r.app.ShowError(r.GetService(), "Account ID must be 12 digits")
+		return
+	}
+
+	var err error
+	if _, ok := r.snapshot.(model.RDSClusterSnapshot); ok {
+		err = r.repo.ShareClusterSnapshot(r.snapshotId, accountId)
+	} else {
+		err = r.repo.ShareInstanceSnapshot(r.snapshotId, accountId)
+	}
+	if err != nil {
+		r.app.ShowError(r.GetService(), fmt.Sprintf("Share snapshot failed: %v", err))
+		return
+	}
+
+	r.app.Close()
+	r.app.ShowMessage(r.GetService(), "Shared snapshot "+r.snapshotId+" with account "+accountId)
+}
+
+func (r *RDSShareSnapshotForm) cancelHandler() {
+	r.app.Close()
+}
+
+func (r RDSShareSnapshotForm) GetLabels() []string {
+	return []string{r.snapshotId, "Share"}
+}
+
+func (r RDSShareSnapshotForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (r RDSShareSnapshotForm) Render() {
+}
@@ -0,0 +1,257 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type RDSSnapshots struct {
+	*ui.Table
+	view.RDS
+	repo  *repo.RDS
+	app   *Application
+	model []model.ModelWithArn
+}
+
+func NewRDSSnapshots(repo *repo.RDS, app *Application) *RDSSnapshots {
+	r := &RDSSnapshots{
+		Table: ui.NewTable([]string{
+			"NAME",
+			"SOURCE",
+			"KIND",
+			"TYPE",
+			"ENGINE",
+			"SIZE",
+			"ENCRYPTED",
+			"STATUS",
+			"CREATED",
+			"AGE",
+		}, 1, 0),
+		repo: repo,
+		app:  app,
+	}
+	return r
+}
+
+func (r RDSSnapshots) GetLabels() []string {
+	return []string{"Snapshots"}
+}
+
+// getRDSSnapshotType distinguishes snapshots shared from other accounts, which are identified by their ARN
+func getRDSSnapshotType(id string, snapshotType *string) string {
+	if strings.HasPrefix(id, "arn:") {
+		return "Shared"
+	}
+	return utils.TitleCase(utils.DerefString(snapshotType, ""))
+}
+
+func (r RDSSnapshots) getSelection() (model.ModelWithArn, string, string, error) {
+	row, err := r.GetRowSelection()
+	if err != nil {
+		return nil, "", "", err
+	}
+	name, _ := r.GetColSelection("NAME")
+	snapshotType, _ := r.GetColSelection("TYPE")
+	return r.model[row-1], name, snapshotType, nil
+}
+
+func (r *RDSSnapshots) copyHandler() {
+	snapshot, name, _, err := r.getSelection()
+	if err != nil {
+		return
+	}
+	copyForm := NewRDSCopySnapshotForm(r.repo, snapshot, name, r.app, r.Render)
+	r.app.AddAndSwitch(copyForm)
+}
+
+func (r RDSSnapshots) shareHandler() {
+	snapshot, name, snapshotType, err := r.getSelection()
+	if err != nil {
+		return
+	}
+	if snapshotType != "Manual" {
+		r.app.ShowError(r.GetService(), "Only manual snapshots owned by this account can be shared")
+		return
+	}
+	shareForm := NewRDSShareSnapshotForm(r.repo, snapshot, name, r.app)
+	r.app.AddAndSwitch(shareForm)
+}
+
+func (r *RDSSnapshots) deleteHandler() {
+	snapshot, name, snapshotType, err := r.getSelection()
+	if err != nil {
+		return
+	}
+	switch snapshotType {
+	case "Automated":
+		r.app.ShowError(r.GetService(), "Automated snapshots are deleted when they reach the end of the backup retention period")
+		return
+	case "Shared":
+		r.app.ShowError(r.GetService(), "Shared snapshots can only be deleted by the account that owns them")
+		return
+	}
+	r.app.Confirm(r.GetService(), "Delete snapshot "+name+"? This cannot be undone.", "Delete", func() {
+		var err error
+		if _, ok := snapshot.(model.RDSClusterSnapshot); ok {
+			err = r.repo.DeleteClusterSnapshot(name)
+		} else {
+			err = r.repo.DeleteInstanceSnapshot(name)
+		}
+		if err != nil {
+			r.app.ShowError(r.GetService(), fmt.Sprintf("Delete snapshot failed: %v", err))
+			return
+		}
+		r.Render()
+	})
+}
+
+func (r RDSSnapshots) tagsHandler() {
+	snapshot, _, snapshotType, err := r.getSelection()
+	if err != nil || snapshotType == "Shared" {
+		return
+	}
+	tagsView := NewTags(r.repo, r.GetService(), snapshot.Arn(), r.app)
+	r.app.AddAndSwitch(tagsView)
+}
+
+func (r *RDSSnapshots) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'C', tcell.ModNone),
+			Description: "Copy",
+			Action:      r.copyHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone),
+			Description: "Share",
+			Action:      r.shareHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyBackspace, 0, tcell.ModNone),
+			Description: "Delete",
+			Action:      r.deleteHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyDelete, 0, tcell.ModNone),
+			Description: "Delete",
+			Action:      r.deleteHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
+			Description: "Tags",
+			Action:      r.tagsHandler,
+		},
+	}
+}
+
+func (r *RDSSnapshots) Render() {
+	clusterSnapshots, err := r.repo.ListClusterSnapshots()
+	if err != nil {
+		panic(err)
+	}
+
+	instanceSnapshots, err := r.repo.ListInstanceSnapshots()
+	if err != nil {
+		panic(err)
+	}
+
+	type row struct {
+		snapshot model.ModelWithArn
+		created  *time.Time
+		data     []string
+	}
+	formatRow := func(name, source, kind, snapshotType, engine, engineVersion string, size *int32, encrypted *bool, status string, created *time.Time) []string {
+		var sizeStr, encryptedStr, createdStr, age string
+		if size != nil {
+			sizeStr = strconv.Itoa(int(*size)) + " GiB"
+		}
+		if encrypted != nil {
+			encryptedStr = utils.BoolToString(*encrypted, "Yes", "No")
+		}
+		if created != nil {
+			createdStr = created.Format(utils.DefaultTimeFormat)
+			age = utils.FormatSeconds(int64(time.Since(*created).Seconds()))
+		}
+		if engineVersion != "" {
+			engine += " " + engineVersion
+		}
+		return []string{
+			name,
+			source,
+			kind,
+			snapshotType,
+			engine,
+			sizeStr,
+			encryptedStr,
+			utils.TitleCase(status),
+			createdStr,
+			age,
+		}
+	}
+
+	var rows []row
+	for _, v := range clusterSnapshots {
+		name := utils.DerefString(v.DBClusterSnapshotIdentifier, "")
+		rows = append(rows, row{
+			snapshot: v,
+			created:  v.SnapshotCreateTime,
+			data: formatRow(
+				name,
+				utils.DerefString(v.DBClusterIdentifier, ""),
+				"Cluster",
+				getRDSSnapshotType(name, v.SnapshotType),
+				utils.DerefString(v.Engine, ""),
+				utils.DerefString(v.EngineVersion, ""),
+				v.AllocatedStorage,
+				v.StorageEncrypted,
+				utils.DerefString(v.Status, ""),
+				v.SnapshotCreateTime,
+			),
+		})
+	}
+	for _, v := range instanceSnapshots {
+		name := utils.DerefString(v.DBSnapshotIdentifier, "")
+		rows = append(rows, row{
+			snapshot: v,
+			created:  v.SnapshotCreateTime,
+			data: formatRow(
+				name,
+				utils.DerefString(v.DBInstanceIdentifier, ""),
+				"Instance",
+				getRDSSnapshotType(name, v.SnapshotType),
+				utils.DerefString(v.Engine, ""),
+				utils.DerefString(v.EngineVersion, ""),
+				v.AllocatedStorage,
+				v.Encrypted,
+				utils.DerefString(v.Status, ""),
+				v.SnapshotCreateTime,
+			),
+		})
+	}
+	// newest first; snapshots that are still being created don't have a time yet
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].created == nil || rows[j].created == nil {
+			return rows[i].created == nil && rows[j].created != nil
+		}
+		return rows[i].created.After(*rows[j].created)
+	})
+
+	var objects []model.ModelWithArn
+	var data [][]string
+	for _, v := range rows {
+		objects = append(objects, v.snapshot)
+		data = append(data, v.data)
+	}
+	r.model = objects
+	r.SetData(data)
+}
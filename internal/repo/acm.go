@@ -60,6 +60,54 @@ func (a ACM) GetCertificate(certificateArn string) (string, error) {
 	return *out.Certificate, nil
 }
 
+func (a ACM) DescribeCertificate(certificateArn string) (model.ACMCertificateDetail, error) {
+	out, err := a.acmClient.DescribeCertificate(
+		context.TODO(),
+		&acm.DescribeCertificateInput{
+			CertificateArn: aws.String(certificateArn),
+		},
+	)
+	if err != nil || out.Certificate == nil {
+		return model.ACMCertificateDetail{}, err
+	}
+	return model.ACMCertificateDetail(*out.Certificate), nil
+}
+
+// RequestCertificate requests a public certificate and returns its ARN
+func (a ACM) RequestCertificate(domainName string, subjectAlternativeNames []string, validationMethod acmTypes.ValidationMethod) (string, error) {
+	input := &acm.RequestCertificateInput{
+		DomainName:       aws.String(domainName),
+		ValidationMethod: validationMethod,
+	}
+	if len(subjectAlternativeNames) > 0 {
+		input.SubjectAlternativeNames = subjectAlternativeNames
+	}
+	out, err := a.acmClient.RequestCertificate(context.TODO(), input)
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(out.CertificateArn), nil
+}
+
+// ImportCertificate imports a certificate, replacing the one with the given ARN if set, and returns its ARN
+func (a ACM) ImportCertificate(certificateArn string, certificate, privateKey, chain []byte) (string, error) {
+	input := &acm.ImportCertificateInput{
+		Certificate: certificate,
+		PrivateKey:  privateKey,
+	}
+	if certificateArn != "" {
+		input.CertificateArn = aws.String(certificateArn)
+	}
+	if len(chain) > 0 {
+		input.CertificateChain = chain
+	}
+	out, err := a.acmClient.ImportCertificate(context.TODO(), input)
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(out.CertificateArn), nil
+}
+
 func (a ACM) ListTags(resourceId string) (model.Tags, error) {
 	out, err := a.acmClient.ListTagsForCertificate(
 		context.TODO(),
@@ -7,6 +7,8 @@ import (
 	cf "github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	"github.com/bporter816/aws-tui/internal/model"
+	"strconv"
+	"time"
 )
 
 type CloudFront struct {
@@ -137,6 +139,30 @@ func (c CloudFront) ListInvalidationPaths(distributionId string, invalidationId
 	return paths, nil
 }
 
+// CreateInvalidation invalidates the paths in the distribution's edge caches, returning the new invalidation's id
+func (c CloudFront) CreateInvalidation(distributionId string, paths []string) (string, error) {
+	out, err := c.cfClient.CreateInvalidation(
+		context.TODO(),
+		&cf.CreateInvalidationInput{
+			DistributionId: aws.String(distributionId),
+			InvalidationBatch: &cfTypes.InvalidationBatch{
+				CallerReference: aws.String(strconv.FormatInt(time.Now().UnixNano(), 10)),
+				Paths: &cfTypes.Paths{
+					Items:    paths,
+					Quantity: aws.Int32(int32(len(paths))),
+				},
+			},
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	if out.Invalidation == nil {
+		return "", nil
+	}
+	return aws.ToString(out.Invalidation.Id), nil
+}
+
 func (c CloudFront) ListFunctions() ([]model.CloudFrontFunction, error) {
 	// ListFunctions doesn't have a paginator
 	var functions []model.CloudFrontFunction
@@ -162,7 +188,8 @@ func (c CloudFront) ListFunctions() ([]model.CloudFrontFunction, error) {
 	return functions, nil
 }
 
-func (c CloudFront) GetFunctionCode(name string, stage string) (string, error) {
+// GetFunctionCode gets the code of a function stage, along with the etag that changes to the stage must match
+func (c CloudFront) GetFunctionCode(name string, stage string) (string, string, error) {
 	var stg cfTypes.FunctionStage
 	// TODO this has to know about case, refactor
 	if stage == "Development" {
@@ -170,7 +197,7 @@ func (c CloudFront) GetFunctionCode(name string, stage string) (string, error) {
 	} else if stage == "Live" {
 		stg = cfTypes.FunctionStageLive
 	} else {
-		return "", errors.New("invalid function stage")
+		return "", "", errors.New("invalid function stage")
 	}
 	out, err := c.cfClient.GetFunction(
 		context.TODO(),
@@ -180,9 +207,83 @@ func (c CloudFront) GetFunctionCode(name string, stage string) (string, error) {
 		},
 	)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	return string(out.FunctionCode), nil
+	return string(out.FunctionCode), aws.ToString(out.ETag), nil
+}
+
+// describeDevelopmentFunction gets the etag and config of the DEVELOPMENT stage, which every change is made against
+func (c CloudFront) describeDevelopmentFunction(name string) (*string, *cfTypes.FunctionConfig, error) {
+	out, err := c.cfClient.DescribeFunction(
+		context.TODO(),
+		&cf.DescribeFunctionInput{
+			Name:  aws.String(name),
+			Stage: cfTypes.FunctionStageDevelopment,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if out.FunctionSummary == nil {
+		return out.ETag, nil, nil
+	}
+	return out.ETag, out.FunctionSummary.FunctionConfig, nil
+}
+
+// UpdateFunctionCode replaces the DEVELOPMENT code of a function, keeping its config. The etag is the one the code
+// was read with, so the update fails with PreconditionFailed if the stage changed since.
+func (c CloudFront) UpdateFunctionCode(name string, code string, etag string) error {
+	_, config, err := c.describeDevelopmentFunction(name)
+	if err != nil {
+		return err
+	}
+	_, err = c.cfClient.UpdateFunction(
+		context.TODO(),
+		&cf.UpdateFunctionInput{
+			Name:           aws.String(name),
+			IfMatch:        aws.String(etag),
+			FunctionCode:   []byte(code),
+			FunctionConfig: config,
+		},
+	)
+	return err
+}
+
+// TestFunction runs the DEVELOPMENT stage of a function against an event object
+func (c CloudFront) TestFunction(name string, eventObject string) (model.CloudFrontFunctionTestResult, error) {
+	etag, _, err := c.describeDevelopmentFunction(name)
+	if err != nil {
+		return model.CloudFrontFunctionTestResult{}, err
+	}
+	out, err := c.cfClient.TestFunction(
+		context.TODO(),
+		&cf.TestFunctionInput{
+			Name:        aws.String(name),
+			IfMatch:     etag,
+			Stage:       cfTypes.FunctionStageDevelopment,
+			EventObject: []byte(eventObject),
+		},
+	)
+	if err != nil {
+		return model.CloudFrontFunctionTestResult{}, err
+	}
+	if out.TestResult == nil {
+		return model.CloudFrontFunctionTestResult{}, errors.New("empty test result")
+	}
+	return model.CloudFrontFunctionTestResult(*out.TestResult), nil
+}
+
+// PublishFunction copies the DEVELOPMENT stage of a function to LIVE, as long as it still matches the etag it was
+// read with
+func (c CloudFront) PublishFunction(name string, etag string) error {
+	_, err := c.cfClient.PublishFunction(
+		context.TODO(),
+		&cf.PublishFunctionInput{
+			Name:    aws.String(name),
+			IfMatch: aws.String(etag),
+		},
+	)
+	return err
 }
 
 func (c CloudFront) ListTags(resourceId string) (model.Tags, error) {
@@ -51,3 +51,23 @@ func (c CloudWatch) ListTags(resourceArn string) (model.Tags, error) {
 	}
 	return tags, nil
 }
+
+func (c CloudWatch) ListLogEvents(logGroupName string, logStreamName string) ([]model.CloudWatchLogEvent, error) {
+	// only fetch the most recent page of events, which is up to 10,000 events or 1 MB
+	out, err := c.cwLogsClient.GetLogEvents(
+		context.TODO(),
+		&cwLogs.GetLogEventsInput{
+			LogGroupName:  aws.String(logGroupName),
+			LogStreamName: aws.String(logStreamName),
+			StartFromHead: aws.Bool(false),
+		},
+	)
+	if err != nil {
+		return []model.CloudWatchLogEvent{}, err
+	}
+	var events []model.CloudWatchLogEvent
+	for _, v := range out.Events {
+		events = append(events, model.CloudWatchLogEvent(v))
+	}
+	return events, nil
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"errors"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/bporter816/aws-tui/internal/model"
@@ -197,3 +198,45 @@ func (e ECS) ListTags(resourceId string) (model.Tags, error) {
 	}
 	return tags, nil
 }
+
+func (e ECS) GetTask(clusterName string, taskArn string) (model.ECSTask, error) {
+	out, err := e.ecsClient.DescribeTasks(
+		context.TODO(),
+		&ecs.DescribeTasksInput{
+			Cluster: aws.String(clusterName),
+			Tasks:   []string{taskArn},
+		},
+	)
+	if err != nil {
+		return model.ECSTask{}, err
+	}
+	if len(out.Tasks) != 1 {
+		return model.ECSTask{}, errors.New("task not found")
+	}
+	return model.ECSTask(out.Tasks[0]), nil
+}
+
+func (e ECS) StopTask(clusterName string, taskArn string, reason string) error {
+	input := &ecs.StopTaskInput{
+		Cluster: aws.String(clusterName),
+		Task:    aws.String(taskArn),
+	}
+	if len(reason) > 0 {
+		input.Reason = aws.String(reason)
+	}
+	_, err := e.ecsClient.StopTask(context.TODO(), input)
+	return err
+}
+
+func (e ECS) GetTaskDefinition(taskDefinitionArn string) (model.ECSTaskDefinition, error) {
+	out, err := e.ecsClient.DescribeTaskDefinition(
+		context.TODO(),
+		&ecs.DescribeTaskDefinitionInput{
+			TaskDefinition: aws.String(taskDefinitionArn),
+		},
+	)
+	if err != nil || out.TaskDefinition == nil {
+		return model.ECSTaskDefinition{}, err
+	}
+	return model.ECSTaskDefinition(*out.TaskDefinition), nil
+}
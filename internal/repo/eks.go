@@ -54,6 +54,215 @@ func (e EKS) ListClusters() ([]model.EKSCluster, error) {
 	return clusters, nil
 }
 
+func (e EKS) GetCluster(name string) (model.EKSCluster, error) {
+	cluster, err := e.describeCluster(name)
+	if err != nil {
+		return model.EKSCluster{}, err
+	}
+	return model.EKSCluster(cluster), nil
+}
+
+func (e EKS) ListNodegroups(clusterName string) ([]model.EKSNodegroup, error) {
+	pg := eks.NewListNodegroupsPaginator(
+		e.eksClient,
+		&eks.ListNodegroupsInput{
+			ClusterName: aws.String(clusterName),
+		},
+	)
+	var nodegroups []model.EKSNodegroup
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.EKSNodegroup{}, err
+		}
+		for _, v := range out.Nodegroups {
+			details, err := e.eksClient.DescribeNodegroup(
+				context.TODO(),
+				&eks.DescribeNodegroupInput{
+					ClusterName:   aws.String(clusterName),
+					NodegroupName: aws.String(v),
+				},
+			)
+			if err != nil {
+				return []model.EKSNodegroup{}, err
+			}
+			nodegroups = append(nodegroups, model.EKSNodegroup(*details.Nodegroup))
+		}
+	}
+	return nodegroups, nil
+}
+
+func (e EKS) GetNodegroup(clusterName string, nodegroupName string) (model.EKSNodegroup, error) {
+	out, err := e.eksClient.DescribeNodegroup(
+		context.TODO(),
+		&eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(clusterName),
+			NodegroupName: aws.String(nodegroupName),
+		},
+	)
+	if err != nil {
+		return model.EKSNodegroup{}, err
+	}
+	return model.EKSNodegroup(*out.Nodegroup), nil
+}
+
+func (e EKS) ListFargateProfiles(clusterName string) ([]model.EKSFargateProfile, error) {
+	pg := eks.NewListFargateProfilesPaginator(
+		e.eksClient,
+		&eks.ListFargateProfilesInput{
+			ClusterName: aws.String(clusterName),
+		},
+	)
+	var profiles []model.EKSFargateProfile
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.EKSFargateProfile{}, err
+		}
+		for _, v := range out.FargateProfileNames {
+			details, err := e.eksClient.DescribeFargateProfile(
+				context.TODO(),
+				&eks.DescribeFargateProfileInput{
+					ClusterName:        aws.String(clusterName),
+					FargateProfileName: aws.String(v),
+				},
+			)
+			if err != nil {
+				return []model.EKSFargateProfile{}, err
+			}
+			profiles = append(profiles, model.EKSFargateProfile(*details.FargateProfile))
+		}
+	}
+	return profiles, nil
+}
+
+// Internal function to get the add-on versions available for a Kubernetes version
+func (e EKS) listAddonVersions(addonName string, kubernetesVersion string) ([]string, error) {
+	pg := eks.NewDescribeAddonVersionsPaginator(
+		e.eksClient,
+		&eks.DescribeAddonVersionsInput{
+			AddonName:         aws.String(addonName),
+			KubernetesVersion: aws.String(kubernetesVersion),
+		},
+	)
+	var versions []string
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []string{}, err
+		}
+		for _, v := range out.Addons {
+			for _, vv := range v.AddonVersions {
+				if vv.AddonVersion != nil {
+					versions = append(versions, *vv.AddonVersion)
+				}
+			}
+		}
+	}
+	return versions, nil
+}
+
+func (e EKS) ListAddons(clusterName string, kubernetesVersion string) ([]model.EKSAddon, error) {
+	pg := eks.NewListAddonsPaginator(
+		e.eksClient,
+		&eks.ListAddonsInput{
+			ClusterName: aws.String(clusterName),
+		},
+	)
+	var addons []model.EKSAddon
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.EKSAddon{}, err
+		}
+		for _, v := range out.Addons {
+			details, err := e.eksClient.DescribeAddon(
+				context.TODO(),
+				&eks.DescribeAddonInput{
+					ClusterName: aws.String(clusterName),
+					AddonName:   aws.String(v),
+				},
+			)
+			if err != nil {
+				return []model.EKSAddon{}, err
+			}
+			addon := model.EKSAddon{Addon: *details.Addon}
+			// available versions are informational, so don't fail the whole list if they can't be found
+			if versions, err := e.listAddonVersions(v, kubernetesVersion); err == nil {
+				addon.AvailableVersions = versions
+			}
+			addons = append(addons, addon)
+		}
+	}
+	return addons, nil
+}
+
+func (e EKS) ListIdentityProviderConfigs(clusterName string) ([]model.EKSIdentityProviderConfig, error) {
+	pg := eks.NewListIdentityProviderConfigsPaginator(
+		e.eksClient,
+		&eks.ListIdentityProviderConfigsInput{
+			ClusterName: aws.String(clusterName),
+		},
+	)
+	var configs []model.EKSIdentityProviderConfig
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.EKSIdentityProviderConfig{}, err
+		}
+		for _, v := range out.IdentityProviderConfigs {
+			details, err := e.eksClient.DescribeIdentityProviderConfig(
+				context.TODO(),
+				&eks.DescribeIdentityProviderConfigInput{
+					ClusterName: aws.String(clusterName),
+					IdentityProviderConfig: &eksTypes.IdentityProviderConfig{
+						Name: v.Name,
+						Type: v.Type,
+					},
+				},
+			)
+			if err != nil {
+				return []model.EKSIdentityProviderConfig{}, err
+			}
+			// OIDC is the only supported identity provider type
+			if details.IdentityProviderConfig != nil && details.IdentityProviderConfig.Oidc != nil {
+				configs = append(configs, model.EKSIdentityProviderConfig(*details.IdentityProviderConfig.Oidc))
+			}
+		}
+	}
+	return configs, nil
+}
+
+func (e EKS) ListAccessEntries(clusterName string) ([]model.EKSAccessEntry, error) {
+	pg := eks.NewListAccessEntriesPaginator(
+		e.eksClient,
+		&eks.ListAccessEntriesInput{
+			ClusterName: aws.String(clusterName),
+		},
+	)
+	var entries []model.EKSAccessEntry
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.EKSAccessEntry{}, err
+		}
+		for _, v := range out.AccessEntries {
+			details, err := e.eksClient.DescribeAccessEntry(
+				context.TODO(),
+				&eks.DescribeAccessEntryInput{
+					ClusterName:  aws.String(clusterName),
+					PrincipalArn: aws.String(v),
+				},
+			)
+			if err != nil {
+				return []model.EKSAccessEntry{}, err
+			}
+			entries = append(entries, model.EKSAccessEntry(*details.AccessEntry))
+		}
+	}
+	return entries, nil
+}
+
 func (e EKS) ListTags(arn string) (model.Tags, error) {
 	out, err := e.eksClient.ListTagsForResource(
 		context.TODO(),
@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	cw "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	ec "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	ecTypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/bporter816/aws-tui/internal/model"
 	"time"
 )
@@ -184,6 +185,30 @@ func (e ElastiCache) ListParameters(parameterGroupName string) ([]model.ElastiCa
 	return parameters, nil
 }
 
+// ListEngineDefaultParameters gets the parameters a new group in the family starts with
+func (e ElastiCache) ListEngineDefaultParameters(family string) ([]model.ElastiCacheParameter, error) {
+	pg := ec.NewDescribeEngineDefaultParametersPaginator(
+		e.ecClient,
+		&ec.DescribeEngineDefaultParametersInput{
+			CacheParameterGroupFamily: aws.String(family),
+		},
+	)
+	var parameters []model.ElastiCacheParameter
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.ElastiCacheParameter{}, err
+		}
+		if out.EngineDefaults == nil {
+			continue
+		}
+		for _, v := range out.EngineDefaults.Parameters {
+			parameters = append(parameters, model.ElastiCacheParameter(v))
+		}
+	}
+	return parameters, nil
+}
+
 func (e ElastiCache) ListUsers() ([]model.ElastiCacheUser, error) {
 	pg := ec.NewDescribeUsersPaginator(
 		e.ecClient,
@@ -282,6 +307,97 @@ func (e ElastiCache) ListUpdateActions(
 	return updateActions, nil
 }
 
+func (e ElastiCache) GetReplicationGroup(replicationGroupId string) (model.ElastiCacheReplicationGroup, error) {
+	out, err := e.ecClient.DescribeReplicationGroups(
+		context.TODO(),
+		&ec.DescribeReplicationGroupsInput{
+			ReplicationGroupId: aws.String(replicationGroupId),
+		},
+	)
+	if err != nil {
+		return model.ElastiCacheReplicationGroup{}, err
+	}
+	if len(out.ReplicationGroups) != 1 {
+		return model.ElastiCacheReplicationGroup{}, errors.New("replication group not found")
+	}
+	return model.ElastiCacheReplicationGroup(out.ReplicationGroups[0]), nil
+}
+
+// ListReplicationGroupMembers gets the member cache clusters of a replication group, including their node details.
+// Members that were removed since the replication group was described are skipped.
+func (e ElastiCache) ListReplicationGroupMembers(memberClusters []string) ([]model.ElastiCacheCacheCluster, error) {
+	var clusters []model.ElastiCacheCacheCluster
+	for _, id := range memberClusters {
+		out, err := e.ecClient.DescribeCacheClusters(
+			context.TODO(),
+			&ec.DescribeCacheClustersInput{
+				CacheClusterId:    aws.String(id),
+				ShowCacheNodeInfo: aws.Bool(true),
+			},
+		)
+		if err != nil {
+			var notFound *ecTypes.CacheClusterNotFoundFault
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return []model.ElastiCacheCacheCluster{}, err
+		}
+		for _, v := range out.CacheClusters {
+			clusters = append(clusters, model.ElastiCacheCacheCluster(v))
+		}
+	}
+	return clusters, nil
+}
+
+// TestFailover promotes a replica in the node group to primary
+func (e ElastiCache) TestFailover(replicationGroupId string, nodeGroupId string) error {
+	_, err := e.ecClient.TestFailover(
+		context.TODO(),
+		&ec.TestFailoverInput{
+			ReplicationGroupId: aws.String(replicationGroupId),
+			NodeGroupId:        aws.String(nodeGroupId),
+		},
+	)
+	return err
+}
+
+func (e ElastiCache) RebootNode(cacheClusterId string, cacheNodeId string) error {
+	_, err := e.ecClient.RebootCacheCluster(
+		context.TODO(),
+		&ec.RebootCacheClusterInput{
+			CacheClusterId:       aws.String(cacheClusterId),
+			CacheNodeIdsToReboot: []string{cacheNodeId},
+		},
+	)
+	return err
+}
+
+// IncreaseReplicaCount sets the number of replicas in every node group, applying immediately
+func (e ElastiCache) IncreaseReplicaCount(replicationGroupId string, replicaCount int32) error {
+	_, err := e.ecClient.IncreaseReplicaCount(
+		context.TODO(),
+		&ec.IncreaseReplicaCountInput{
+			ReplicationGroupId: aws.String(replicationGroupId),
+			NewReplicaCount:    aws.Int32(replicaCount),
+			ApplyImmediately:   aws.Bool(true),
+		},
+	)
+	return err
+}
+
+// DecreaseReplicaCount sets the number of replicas in every node group, applying immediately
+func (e ElastiCache) DecreaseReplicaCount(replicationGroupId string, replicaCount int32) error {
+	_, err := e.ecClient.DecreaseReplicaCount(
+		context.TODO(),
+		&ec.DecreaseReplicaCountInput{
+			ReplicationGroupId: aws.String(replicationGroupId),
+			NewReplicaCount:    aws.Int32(replicaCount),
+			ApplyImmediately:   aws.Bool(true),
+		},
+	)
+	return err
+}
+
 func (e ElastiCache) ListTags(arn string) (model.Tags, error) {
 	out, err := e.ecClient.ListTagsForResource(
 		context.TODO(),
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbTypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/bporter816/aws-tui/internal/model"
 )
 
@@ -59,7 +60,7 @@ func (e ELB) ListListeners(loadBalancerArn string) ([]model.ELBListener, error)
 		for _, v := range out.Listeners {
 			m := model.ELBListener{Listener: v}
 			if v.ListenerArn != nil {
-				if rules, err := e.ListListenerRules(*v.ListenerArn); err != nil {
+				if rules, err := e.ListListenerRules(*v.ListenerArn); err == nil {
 					m.Rules = len(rules)
 				}
 			}
@@ -112,6 +113,90 @@ func (e ELB) ListTargetGroups() ([]model.ELBTargetGroup, error) {
 	return targetGroups, nil
 }
 
+func (e ELB) ListTargetHealth(targetGroupArn string) ([]model.ELBTargetHealth, error) {
+	out, err := e.elbClient.DescribeTargetHealth(
+		context.TODO(),
+		&elb.DescribeTargetHealthInput{
+			TargetGroupArn: aws.String(targetGroupArn),
+		},
+	)
+	if err != nil {
+		return []model.ELBTargetHealth{}, err
+	}
+	var targets []model.ELBTargetHealth
+	for _, v := range out.TargetHealthDescriptions {
+		targets = append(targets, model.ELBTargetHealth(v))
+	}
+	return targets, nil
+}
+
+// targetDescription builds a target, where a port of 0 means the target group's port
+func targetDescription(id string, port int32) elbTypes.TargetDescription {
+	target := elbTypes.TargetDescription{
+		Id: aws.String(id),
+	}
+	if port != 0 {
+		target.Port = aws.Int32(port)
+	}
+	return target
+}
+
+func (e ELB) RegisterTarget(targetGroupArn string, id string, port int32) error {
+	_, err := e.elbClient.RegisterTargets(
+		context.TODO(),
+		&elb.RegisterTargetsInput{
+			TargetGroupArn: aws.String(targetGroupArn),
+			Targets:        []elbTypes.TargetDescription{targetDescription(id, port)},
+		},
+	)
+	return err
+}
+
+func (e ELB) DeregisterTarget(targetGroupArn string, id string, port int32) error {
+	_, err := e.elbClient.DeregisterTargets(
+		context.TODO(),
+		&elb.DeregisterTargetsInput{
+			TargetGroupArn: aws.String(targetGroupArn),
+			Targets:        []elbTypes.TargetDescription{targetDescription(id, port)},
+		},
+	)
+	return err
+}
+
+func (e ELB) GetTargetGroupAttributes(targetGroupArn string) (map[string]string, error) {
+	out, err := e.elbClient.DescribeTargetGroupAttributes(
+		context.TODO(),
+		&elb.DescribeTargetGroupAttributesInput{
+			TargetGroupArn: aws.String(targetGroupArn),
+		},
+	)
+	if err != nil {
+		return map[string]string{}, err
+	}
+	attributes := make(map[string]string)
+	for _, v := range out.Attributes {
+		if v.Key != nil {
+			attributes[*v.Key] = aws.ToString(v.Value)
+		}
+	}
+	return attributes, nil
+}
+
+func (e ELB) SetTargetGroupAttributes(targetGroupArn string, attributes map[string]string) error {
+	var attrs []elbTypes.TargetGroupAttribute
+	for k, v := range attributes {
+		attrs = append(attrs, elbTypes.TargetGroupAttribute{Key: aws.String(k), Value: aws.String(v)})
+	}
+	_, err := e.elbClient.ModifyTargetGroupAttributes(
+		context.TODO(),
+		&elb.ModifyTargetGroupAttributesInput{
+			TargetGroupArn: aws.String(targetGroupArn),
+			Attributes:     attrs,
+		},
+	)
+	return err
+}
+
 func (e ELB) ListTags(resourceArn string) (model.Tags, error) {
 	out, err := e.elbClient.DescribeTags(
 		context.TODO(),
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
@@ -39,6 +40,20 @@ func (k KMS) getAliasMap() (map[string][]string, error) {
 	return aliasMap, nil
 }
 
+// ListAliases returns the names of the aliases that point to a key
+func (k KMS) ListAliases() ([]string, error) {
+	aliasMap, err := k.getAliasMap()
+	if err != nil {
+		return []string{}, err
+	}
+	var aliases []string
+	for _, v := range aliasMap {
+		aliases = append(aliases, v...)
+	}
+	sort.Strings(aliases)
+	return aliases, nil
+}
+
 func (k KMS) describeKey(keyId string) (kmsTypes.KeyMetadata, error) {
 	out, err := k.kmsClient.DescribeKey(
 		context.TODO(),
@@ -2,18 +2,27 @@ package repo
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdaTypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/bporter816/aws-tui/internal/model"
+	"io"
+	"net/http"
+	"os"
 )
 
 type Lambda struct {
 	lambdaClient *lambda.Client
+	httpClient   *http.Client
 }
 
-func NewLambda(lambdaClient *lambda.Client) *Lambda {
+func NewLambda(lambdaClient *lambda.Client, httpClient *http.Client) *Lambda {
 	return &Lambda{
 		lambdaClient: lambdaClient,
+		httpClient:   httpClient,
 	}
 }
 
@@ -35,6 +44,219 @@ func (l Lambda) ListFunctions() ([]model.LambdaFunction, error) {
 	return functions, nil
 }
 
+func (l Lambda) GetFunction(functionName string) (model.LambdaFunction, error) {
+	out, err := l.lambdaClient.GetFunction(
+		context.TODO(),
+		&lambda.GetFunctionInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	if err != nil || out.Configuration == nil {
+		return model.LambdaFunction{}, err
+	}
+	return model.LambdaFunction(*out.Configuration), nil
+}
+
+// GetFunctionCode downloads the deployment package of a zip-packaged function
+func (l Lambda) GetFunctionCode(functionName string) ([]byte, error) {
+	out, err := l.lambdaClient.GetFunction(
+		context.TODO(),
+		&lambda.GetFunctionInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	if err != nil {
+		return []byte{}, err
+	}
+	if out.Code == nil || out.Code.Location == nil {
+		if out.Code != nil && out.Code.ImageUri != nil {
+			return []byte{}, fmt.Errorf("function is deployed as a container image: %v", *out.Code.ImageUri)
+		}
+		return []byte{}, errors.New("empty code location for function")
+	}
+	resp, err := l.httpClient.Get(*out.Code.Location)
+	if err != nil {
+		return []byte{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return []byte{}, fmt.Errorf("invalid status code: %v", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (l Lambda) DownloadFunctionCode(functionName string, destPath string) error {
+	code, err := l.GetFunctionCode(functionName)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(destPath, code, 0644)
+}
+
+// GetFunctionConcurrency returns the reserved concurrency of the function, or nil if it is unreserved
+func (l Lambda) GetFunctionConcurrency(functionName string) (*int32, error) {
+	out, err := l.lambdaClient.GetFunctionConcurrency(
+		context.TODO(),
+		&lambda.GetFunctionConcurrencyInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return out.ReservedConcurrentExecutions, nil
+}
+
+func (l Lambda) ListProvisionedConcurrencyConfigs(functionName string) ([]model.LambdaProvisionedConcurrencyConfig, error) {
+	pg := lambda.NewListProvisionedConcurrencyConfigsPaginator(
+		l.lambdaClient,
+		&lambda.ListProvisionedConcurrencyConfigsInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	var configs []model.LambdaProvisionedConcurrencyConfig
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.LambdaProvisionedConcurrencyConfig{}, err
+		}
+		for _, v := range out.ProvisionedConcurrencyConfigs {
+			configs = append(configs, model.LambdaProvisionedConcurrencyConfig(v))
+		}
+	}
+	return configs, nil
+}
+
+func (l Lambda) ListAliases(functionName string) ([]model.LambdaAlias, error) {
+	pg := lambda.NewListAliasesPaginator(
+		l.lambdaClient,
+		&lambda.ListAliasesInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	var aliases []model.LambdaAlias
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.LambdaAlias{}, err
+		}
+		for _, v := range out.Aliases {
+			aliases = append(aliases, model.LambdaAlias(v))
+		}
+	}
+	return aliases, nil
+}
+
+func (l Lambda) ListVersions(functionName string) ([]model.LambdaFunction, error) {
+	pg := lambda.NewListVersionsByFunctionPaginator(
+		l.lambdaClient,
+		&lambda.ListVersionsByFunctionInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	var versions []model.LambdaFunction
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.LambdaFunction{}, err
+		}
+		for _, v := range out.Versions {
+			versions = append(versions, model.LambdaFunction(v))
+		}
+	}
+	return versions, nil
+}
+
+func (l Lambda) ListEventSourceMappings(functionName string) ([]model.LambdaEventSourceMapping, error) {
+	pg := lambda.NewListEventSourceMappingsPaginator(
+		l.lambdaClient,
+		&lambda.ListEventSourceMappingsInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	var mappings []model.LambdaEventSourceMapping
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.LambdaEventSourceMapping{}, err
+		}
+		for _, v := range out.EventSourceMappings {
+			mappings = append(mappings, model.LambdaEventSourceMapping(v))
+		}
+	}
+	return mappings, nil
+}
+
+func (l Lambda) ListFunctionUrlConfigs(functionName string) ([]model.LambdaFunctionUrlConfig, error) {
+	pg := lambda.NewListFunctionUrlConfigsPaginator(
+		l.lambdaClient,
+		&lambda.ListFunctionUrlConfigsInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	var configs []model.LambdaFunctionUrlConfig
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.LambdaFunctionUrlConfig{}, err
+		}
+		for _, v := range out.FunctionUrlConfigs {
+			configs = append(configs, model.LambdaFunctionUrlConfig(v))
+		}
+	}
+	return configs, nil
+}
+
+// GetPolicy returns the resource-based policy of the function, or an empty string if it has none
+func (l Lambda) GetPolicy(functionName string) (string, error) {
+	out, err := l.lambdaClient.GetPolicy(
+		context.TODO(),
+		&lambda.GetPolicyInput{
+			FunctionName: aws.String(functionName),
+		},
+	)
+	if err != nil {
+		var notFound *lambdaTypes.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	if out.Policy == nil {
+		return "", nil
+	}
+	return *out.Policy, nil
+}
+
+func (l Lambda) Invoke(functionName string, payload []byte, invocationType lambdaTypes.InvocationType) (model.LambdaInvocationResult, error) {
+	out, err := l.lambdaClient.Invoke(
+		context.TODO(),
+		&lambda.InvokeInput{
+			FunctionName:   aws.String(functionName),
+			InvocationType: invocationType,
+			LogType:        lambdaTypes.LogTypeTail,
+			Payload:        payload,
+		},
+	)
+	if err != nil {
+		return model.LambdaInvocationResult{}, err
+	}
+	result := model.LambdaInvocationResult{
+		StatusCode:      out.StatusCode,
+		FunctionError:   aws.ToString(out.FunctionError),
+		ExecutedVersion: aws.ToString(out.ExecutedVersion),
+		Payload:         out.Payload,
+	}
+	if out.LogResult != nil {
+		logs, err := base64.StdEncoding.DecodeString(*out.LogResult)
+		if err != nil {
+			return model.LambdaInvocationResult{}, err
+		}
+		result.LogResult = string(logs)
+	}
+	return result, nil
+}
+
 func (l Lambda) ListTags(functionArn string) (model.Tags, error) {
 	out, err := l.lambdaClient.ListTags(
 		context.TODO(),
@@ -153,6 +153,59 @@ func (r RDS) ListInstanceParameters(paramGroupName string) ([]model.RDSParameter
 	return parameters, nil
 }
 
+// ListEngineDefaultParameters gets the parameters a new instance parameter group in the family starts with
+func (r RDS) ListEngineDefaultParameters(family string) ([]model.RDSParameter, error) {
+	pg := rds.NewDescribeEngineDefaultParametersPaginator(
+		r.rdsClient,
+		&rds.DescribeEngineDefaultParametersInput{
+			DBParameterGroupFamily: aws.String(family),
+		},
+	)
+	var parameters []model.RDSParameter
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.RDSParameter{}, err
+		}
+		if out.EngineDefaults == nil {
+			continue
+		}
+		for _, v := range out.EngineDefaults.Parameters {
+			parameters = append(parameters, model.RDSParameter(v))
+		}
+	}
+	return parameters, nil
+}
+
+// ListEngineDefaultClusterParameters gets the parameters a new cluster parameter group in the family starts with
+func (r RDS) ListEngineDefaultClusterParameters(family string) ([]model.RDSParameter, error) {
+	var parameters []model.RDSParameter
+	var marker *string
+	for {
+		out, err := r.rdsClient.DescribeEngineDefaultClusterParameters(
+			context.TODO(),
+			&rds.DescribeEngineDefaultClusterParametersInput{
+				DBParameterGroupFamily: aws.String(family),
+				Marker:                 marker,
+			},
+		)
+		if err != nil {
+			return []model.RDSParameter{}, err
+		}
+		if out.EngineDefaults == nil {
+			break
+		}
+		for _, v := range out.EngineDefaults.Parameters {
+			parameters = append(parameters, model.RDSParameter(v))
+		}
+		marker = out.EngineDefaults.Marker
+		if marker == nil {
+			break
+		}
+	}
+	return parameters, nil
+}
+
 func (r RDS) ListSubnetGroups() ([]model.RDSSubnetGroup, error) {
 	pg := rds.NewDescribeDBSubnetGroupsPaginator(
 		r.rdsClient,
@@ -189,6 +242,286 @@ func (r RDS) ListReservedInstances() ([]model.RDSReservedInstance, error) {
 	return reservedInstances, nil
 }
 
+func (r RDS) RebootInstance(instanceId string, forceFailover bool) error {
+	_, err := r.rdsClient.RebootDBInstance(
+		context.TODO(),
+		&rds.RebootDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceId),
+			ForceFailover:        aws.Bool(forceFailover),
+		},
+	)
+	return err
+}
+
+func (r RDS) StopInstance(instanceId string) error {
+	_, err := r.rdsClient.StopDBInstance(
+		context.TODO(),
+		&rds.StopDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceId),
+		},
+	)
+	return err
+}
+
+func (r RDS) StartInstance(instanceId string) error {
+	_, err := r.rdsClient.StartDBInstance(
+		context.TODO(),
+		&rds.StartDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceId),
+		},
+	)
+	return err
+}
+
+func (r RDS) StopCluster(clusterId string) error {
+	_, err := r.rdsClient.StopDBCluster(
+		context.TODO(),
+		&rds.StopDBClusterInput{
+			DBClusterIdentifier: aws.String(clusterId),
+		},
+	)
+	return err
+}
+
+func (r RDS) StartCluster(clusterId string) error {
+	_, err := r.rdsClient.StartDBCluster(
+		context.TODO(),
+		&rds.StartDBClusterInput{
+			DBClusterIdentifier: aws.String(clusterId),
+		},
+	)
+	return err
+}
+
+// FailoverCluster promotes a reader to writer. If no target is given, RDS picks the reader.
+func (r RDS) FailoverCluster(clusterId string, targetInstanceId string) error {
+	in := &rds.FailoverDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterId),
+	}
+	if targetInstanceId != "" {
+		in.TargetDBInstanceIdentifier = aws.String(targetInstanceId)
+	}
+	_, err := r.rdsClient.FailoverDBCluster(context.TODO(), in)
+	return err
+}
+
+func (r RDS) CreateInstanceSnapshot(instanceId string, snapshotId string) error {
+	_, err := r.rdsClient.CreateDBSnapshot(
+		context.TODO(),
+		&rds.CreateDBSnapshotInput{
+			DBInstanceIdentifier: aws.String(instanceId),
+			DBSnapshotIdentifier: aws.String(snapshotId),
+		},
+	)
+	return err
+}
+
+func (r RDS) CreateClusterSnapshot(clusterId string, snapshotId string) error {
+	_, err := r.rdsClient.CreateDBClusterSnapshot(
+		context.TODO(),
+		&rds.CreateDBClusterSnapshotInput{
+			DBClusterIdentifier:         aws.String(clusterId),
+			DBClusterSnapshotIdentifier: aws.String(snapshotId),
+		},
+	)
+	return err
+}
+
+// ListEvents gets the events for a source from the last 14 days, which is as far back as RDS keeps them
+func (r RDS) ListEvents(sourceType rdsTypes.SourceType, sourceId string) ([]model.RDSEvent, error) {
+	pg := rds.NewDescribeEventsPaginator(
+		r.rdsClient,
+		&rds.DescribeEventsInput{
+			SourceType:       sourceType,
+			SourceIdentifier: aws.String(sourceId),
+			Duration:         aws.Int32(14 * 24 * 60),
+		},
+	)
+	var events []model.RDSEvent
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.RDSEvent{}, err
+		}
+		for _, v := range out.Events {
+			events = append(events, model.RDSEvent(v))
+		}
+	}
+	return events, nil
+}
+
+func (r RDS) ListLogFiles(instanceId string) ([]model.RDSLogFile, error) {
+	pg := rds.NewDescribeDBLogFilesPaginator(
+		r.rdsClient,
+		&rds.DescribeDBLogFilesInput{
+			DBInstanceIdentifier: aws.String(instanceId),
+		},
+	)
+	var logFiles []model.RDSLogFile
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.RDSLogFile{}, err
+		}
+		for _, v := range out.DescribeDBLogFiles {
+			logFiles = append(logFiles, model.RDSLogFile(v))
+		}
+	}
+	return logFiles, nil
+}
+
+// DownloadLogFilePortion gets the last lines of a log file if marker is empty, otherwise the data after the marker
+func (r RDS) DownloadLogFilePortion(instanceId string, logFileName string, marker string, lines int32) (model.RDSLogFilePortion, error) {
+	in := &rds.DownloadDBLogFilePortionInput{
+		DBInstanceIdentifier: aws.String(instanceId),
+		LogFileName:          aws.String(logFileName),
+		NumberOfLines:        aws.Int32(lines),
+	}
+	if marker != "" {
+		in.Marker = aws.String(marker)
+	}
+	out, err := r.rdsClient.DownloadDBLogFilePortion(context.TODO(), in)
+	if err != nil {
+		return model.RDSLogFilePortion{}, err
+	}
+	return model.RDSLogFilePortion{
+		Data:                  aws.ToString(out.LogFileData),
+		Marker:                aws.ToString(out.Marker),
+		AdditionalDataPending: aws.ToBool(out.AdditionalDataPending),
+	}, nil
+}
+
+// ListInstanceSnapshots gets manual and automated snapshots, plus the ones shared from other accounts
+func (r RDS) ListInstanceSnapshots() ([]model.RDSInstanceSnapshot, error) {
+	pg := rds.NewDescribeDBSnapshotsPaginator(
+		r.rdsClient,
+		&rds.DescribeDBSnapshotsInput{
+			IncludeShared: aws.Bool(true),
+		},
+	)
+	var snapshots []model.RDSInstanceSnapshot
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.RDSInstanceSnapshot{}, err
+		}
+		for _, v := range out.DBSnapshots {
+			snapshots = append(snapshots, model.RDSInstanceSnapshot(v))
+		}
+	}
+	return snapshots, nil
+}
+
+// ListClusterSnapshots gets manual and automated cluster snapshots, plus the ones shared from other accounts
+func (r RDS) ListClusterSnapshots() ([]model.RDSClusterSnapshot, error) {
+	pg := rds.NewDescribeDBClusterSnapshotsPaginator(
+		r.rdsClient,
+		&rds.DescribeDBClusterSnapshotsInput{
+			IncludeShared: aws.Bool(true),
+		},
+	)
+	var snapshots []model.RDSClusterSnapshot
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.RDSClusterSnapshot{}, err
+		}
+		for _, v := range out.DBClusterSnapshots {
+			snapshots = append(snapshots, model.RDSClusterSnapshot(v))
+		}
+	}
+	return snapshots, nil
+}
+
+// copyRegionOptions sends a copy to the destination region, letting the SDK presign the request from the source region
+func (r RDS) copyRegionOptions(destRegion string) (*string, []func(*rds.Options)) {
+	sourceRegion := r.rdsClient.Options().Region
+	if destRegion == "" || destRegion == sourceRegion {
+		return nil, nil
+	}
+	return aws.String(sourceRegion), []func(*rds.Options){
+		func(o *rds.Options) {
+			o.Region = destRegion
+		},
+	}
+}
+
+func (r RDS) CopyInstanceSnapshot(sourceArn string, targetId string, destRegion string, kmsKeyId string, copyTags bool) error {
+	sourceRegion, optFns := r.copyRegionOptions(destRegion)
+	in := &rds.CopyDBSnapshotInput{
+		SourceDBSnapshotIdentifier: aws.String(sourceArn),
+		TargetDBSnapshotIdentifier: aws.String(targetId),
+		SourceRegion:               sourceRegion,
+		CopyTags:                   aws.Bool(copyTags),
+	}
+	if kmsKeyId != "" {
+		in.KmsKeyId = aws.String(kmsKeyId)
+	}
+	_, err := r.rdsClient.CopyDBSnapshot(context.TODO(), in, optFns...)
+	return err
+}
+
+func (r RDS) CopyClusterSnapshot(sourceArn string, targetId string, destRegion string, kmsKeyId string, copyTags bool) error {
+	sourceRegion, optFns := r.copyRegionOptions(destRegion)
+	in := &rds.CopyDBClusterSnapshotInput{
+		SourceDBClusterSnapshotIdentifier: aws.String(sourceArn),
+		TargetDBClusterSnapshotIdentifier: aws.String(targetId),
+		SourceRegion:                      sourceRegion,
+		CopyTags:                          aws.Bool(copyTags),
+	}
+	if kmsKeyId != "" {
+		in.KmsKeyId = aws.String(kmsKeyId)
+	}
+	_, err := r.rdsClient.CopyDBClusterSnapshot(context.TODO(), in, optFns...)
+	return err
+}
+
+// ShareInstanceSnapshot allows another account to restore from a manual snapshot
+func (r RDS) ShareInstanceSnapshot(snapshotId string, accountId string) error {
+	_, err := r.rdsClient.ModifyDBSnapshotAttribute(
+		context.TODO(),
+		&rds.ModifyDBSnapshotAttributeInput{
+			DBSnapshotIdentifier: aws.String(snapshotId),
+			AttributeName:        aws.String("restore"),
+			ValuesToAdd:          []string{accountId},
+		},
+	)
+	return err
+}
+
+// ShareClusterSnapshot allows another account to restore from a manual cluster snapshot
+func (r RDS) ShareClusterSnapshot(snapshotId string, accountId string) error {
+	_, err := r.rdsClient.ModifyDBClusterSnapshotAttribute(
+		context.TODO(),
+		&rds.ModifyDBClusterSnapshotAttributeInput{
+			DBClusterSnapshotIdentifier: aws.String(snapshotId),
+			AttributeName:               aws.String("restore"),
+			ValuesToAdd:                 []string{accountId},
+		},
+	)
+	return err
+}
+
+func (r RDS) DeleteInstanceSnapshot(snapshotId string) error {
+	_, err := r.rdsClient.DeleteDBSnapshot(
+		context.TODO(),
+		&rds.DeleteDBSnapshotInput{
+			DBSnapshotIdentifier: aws.String(snapshotId),
+		},
+	)
+	return err
+}
+
+func (r RDS) DeleteClusterSnapshot(snapshotId string) error {
+	_, err := r.rdsClient.DeleteDBClusterSnapshot(
+		context.TODO(),
+		&rds.DeleteDBClusterSnapshotInput{
+			DBClusterSnapshotIdentifier: aws.String(snapshotId),
+		},
+	)
+	return err
+}
+
 func (r RDS) ListTags(resourceId string) (model.Tags, error) {
 	out, err := r.rdsClient.ListTagsForResource(
 		context.TODO(),
@@ -174,3 +174,119 @@ func (r Route53) DeleteRecord(hostedZoneId string, record r53Types.ResourceRecor
 	)
 	return err
 }
+
+// ChangeRecords applies a batch of changes, which Route 53 applies all together or not at all
+func (r Route53) ChangeRecords(hostedZoneId string, changes []r53Types.Change, comment string) (model.Route53ChangeInfo, error) {
+	var batchComment *string
+	if comment != "" {
+		batchComment = aws.String(comment)
+	}
+	out, err := r.r53Client.ChangeResourceRecordSets(
+		context.TODO(),
+		&r53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(hostedZoneId),
+			ChangeBatch: &r53Types.ChangeBatch{
+				Changes: changes,
+				Comment: batchComment,
+			},
+		},
+	)
+	if err != nil || out.ChangeInfo == nil {
+		return model.Route53ChangeInfo{}, err
+	}
+	return model.Route53ChangeInfo(*out.ChangeInfo), nil
+}
+
+func (r Route53) GetChange(changeId string) (model.Route53ChangeInfo, error) {
+	out, err := r.r53Client.GetChange(
+		context.TODO(),
+		&r53.GetChangeInput{
+			Id: aws.String(changeId),
+		},
+	)
+	if err != nil || out.ChangeInfo == nil {
+		return model.Route53ChangeInfo{}, err
+	}
+	return model.Route53ChangeInfo(*out.ChangeInfo), nil
+}
+
+// TestDNSAnswer returns the answer Route 53 gives for a record, optionally as seen by a resolver and client subnet
+func (r Route53) TestDNSAnswer(hostedZoneId, recordName string, recordType r53Types.RRType, resolverIP, clientSubnetIP, clientSubnetMask string) (model.Route53DNSAnswer, error) {
+	input := &r53.TestDNSAnswerInput{
+		HostedZoneId: aws.String(hostedZoneId),
+		RecordName:   aws.String(recordName),
+		RecordType:   recordType,
+	}
+	if resolverIP != "" {
+		input.ResolverIP = aws.String(resolverIP)
+	}
+	if clientSubnetIP != "" {
+		input.EDNS0ClientSubnetIP = aws.String(clientSubnetIP)
+		if clientSubnetMask != "" {
+			input.EDNS0ClientSubnetMask = aws.String(clientSubnetMask)
+		}
+	}
+	out, err := r.r53Client.TestDNSAnswer(context.TODO(), input)
+	if err != nil {
+		return model.Route53DNSAnswer{}, err
+	}
+	return model.Route53DNSAnswer(*out), nil
+}
+
+func (r Route53) GetHealthCheckStatus(healthCheckId string) ([]model.Route53HealthCheckObservation, error) {
+	out, err := r.r53Client.GetHealthCheckStatus(
+		context.TODO(),
+		&r53.GetHealthCheckStatusInput{
+			HealthCheckId: aws.String(healthCheckId),
+		},
+	)
+	if err != nil {
+		return []model.Route53HealthCheckObservation{}, err
+	}
+	var observations []model.Route53HealthCheckObservation
+	for _, v := range out.HealthCheckObservations {
+		observations = append(observations, model.Route53HealthCheckObservation(v))
+	}
+	return observations, nil
+}
+
+func (r Route53) GetHealthCheckLastFailureReason(healthCheckId string) ([]model.Route53HealthCheckObservation, error) {
+	out, err := r.r53Client.GetHealthCheckLastFailureReason(
+		context.TODO(),
+		&r53.GetHealthCheckLastFailureReasonInput{
+			HealthCheckId: aws.String(healthCheckId),
+		},
+	)
+	if err != nil {
+		return []model.Route53HealthCheckObservation{}, err
+	}
+	var observations []model.Route53HealthCheckObservation
+	for _, v := range out.HealthCheckObservations {
+		observations = append(observations, model.Route53HealthCheckObservation(v))
+	}
+	return observations, nil
+}
+
+// ListHealthCheckRecords returns the records in any hosted zone that are associated with the health check
+func (r Route53) ListHealthCheckRecords(healthCheckId string) ([]model.Route53ZoneRecord, error) {
+	hostedZones, err := r.ListHostedZones()
+	if err != nil {
+		return []model.Route53ZoneRecord{}, err
+	}
+	var records []model.Route53ZoneRecord
+	for _, zone := range hostedZones {
+		if zone.Id == nil {
+			continue
+		}
+		zoneRecords, err := r.ListRecords(*zone.Id)
+		if err != nil {
+			return []model.Route53ZoneRecord{}, err
+		}
+		for _, v := range zoneRecords {
+			if v.HealthCheckId != nil && *v.HealthCheckId == healthCheckId {
+				records = append(records, model.Route53ZoneRecord{HostedZone: zone, Record: v})
+			}
+		}
+	}
+	return records, nil
+}
@@ -38,13 +38,15 @@ func (s SecretsManager) ListSecrets() ([]model.SecretsManagerSecret, error) {
 	return secrets, nil
 }
 
-func (s SecretsManager) GetSecretValue(secretName string) (string, error) {
-	out, err := s.smClient.GetSecretValue(
-		context.TODO(),
-		&sm.GetSecretValueInput{
-			SecretId: aws.String(secretName),
-		},
-	)
+// GetSecretValue gets the value of the given version, or the AWSCURRENT version if versionId is empty
+func (s SecretsManager) GetSecretValue(secretName string, versionId string) (string, error) {
+	in := &sm.GetSecretValueInput{
+		SecretId: aws.String(secretName),
+	}
+	if versionId != "" {
+		in.VersionId = aws.String(versionId)
+	}
+	out, err := s.smClient.GetSecretValue(context.TODO(), in)
 	if err != nil {
 		return "", err
 	}
@@ -57,6 +59,66 @@ func (s SecretsManager) GetSecretValue(secretName string) (string, error) {
 	return "", nil
 }
 
+func (s SecretsManager) DescribeSecret(secretName string) (model.SecretsManagerSecretDetails, error) {
+	out, err := s.smClient.DescribeSecret(
+		context.TODO(),
+		&sm.DescribeSecretInput{
+			SecretId: aws.String(secretName),
+		},
+	)
+	if err != nil {
+		return model.SecretsManagerSecretDetails{}, err
+	}
+	return model.SecretsManagerSecretDetails(*out), nil
+}
+
+func (s SecretsManager) ListSecretVersions(secretName string) ([]model.SecretsManagerSecretVersion, error) {
+	pg := sm.NewListSecretVersionIdsPaginator(
+		s.smClient,
+		&sm.ListSecretVersionIdsInput{
+			SecretId:          aws.String(secretName),
+			IncludeDeprecated: aws.Bool(true),
+		},
+	)
+	var versions []model.SecretsManagerSecretVersion
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.SecretsManagerSecretVersion{}, err
+		}
+		for _, v := range out.Versions {
+			versions = append(versions, model.SecretsManagerSecretVersion(v))
+		}
+	}
+	return versions, nil
+}
+
+// PutSecretValue stores a new version of the secret, which becomes AWSCURRENT
+func (s SecretsManager) PutSecretValue(secretName string, value string) (string, error) {
+	out, err := s.smClient.PutSecretValue(
+		context.TODO(),
+		&sm.PutSecretValueInput{
+			SecretId:     aws.String(secretName),
+			SecretString: aws.String(value),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return *out.VersionId, nil
+}
+
+// RotateSecret starts a rotation using the secret's existing rotation configuration
+func (s SecretsManager) RotateSecret(secretName string) error {
+	_, err := s.smClient.RotateSecret(
+		context.TODO(),
+		&sm.RotateSecretInput{
+			SecretId: aws.String(secretName),
+		},
+	)
+	return err
+}
+
 func (s SecretsManager) GetResourcePolicy(secretName string) (string, error) {
 	out, err := s.smClient.GetResourcePolicy(
 		context.TODO(),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snsTypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/bporter816/aws-tui/internal/model"
 )
 
@@ -115,6 +116,96 @@ func (s SNS) ListSubscriptions(topicArn string) ([]model.SNSSubscription, error)
 	return subscriptions, nil
 }
 
+func (s SNS) Publish(topicArn string, subject string, message string, perProtocol bool, attributes map[string]snsTypes.MessageAttributeValue, messageGroupId string, deduplicationId string) (string, error) {
+	in := &sns.PublishInput{
+		TopicArn:          aws.String(topicArn),
+		Message:           aws.String(message),
+		MessageAttributes: attributes,
+	}
+	if subject != "" {
+		in.Subject = aws.String(subject)
+	}
+	if perProtocol {
+		in.MessageStructure = aws.String("json")
+	}
+	if messageGroupId != "" {
+		in.MessageGroupId = aws.String(messageGroupId)
+	}
+	if deduplicationId != "" {
+		in.MessageDeduplicationId = aws.String(deduplicationId)
+	}
+	out, err := s.snsClient.Publish(context.TODO(), in)
+	if err != nil {
+		return "", err
+	}
+	return *out.MessageId, nil
+}
+
+func (s SNS) Subscribe(topicArn string, protocol string, endpoint string, attributes map[string]string) (string, error) {
+	out, err := s.snsClient.Subscribe(
+		context.TODO(),
+		&sns.SubscribeInput{
+			TopicArn:              aws.String(topicArn),
+			Protocol:              aws.String(protocol),
+			Endpoint:              aws.String(endpoint),
+			Attributes:            attributes,
+			ReturnSubscriptionArn: true,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return *out.SubscriptionArn, nil
+}
+
+func (s SNS) Unsubscribe(subscriptionArn string) error {
+	_, err := s.snsClient.Unsubscribe(
+		context.TODO(),
+		&sns.UnsubscribeInput{
+			SubscriptionArn: aws.String(subscriptionArn),
+		},
+	)
+	return err
+}
+
+// GetFilterPolicy returns the subscription's filter policy and its scope
+func (s SNS) GetFilterPolicy(subscriptionArn string) (string, string, error) {
+	attrs, err := s.getSubscriptionAttributes(subscriptionArn)
+	if err != nil {
+		return "", "", err
+	}
+	return attrs["FilterPolicy"], attrs["FilterPolicyScope"], nil
+}
+
+// SetFilterPolicy replaces the subscription's filter policy. An empty policy removes it.
+func (s SNS) SetFilterPolicy(subscriptionArn string, policy string, scope string) error {
+	if policy == "" {
+		policy = "{}"
+	} else if scope != "" {
+		// set the scope first, since the policy is validated against it
+		_, err := s.snsClient.SetSubscriptionAttributes(
+			context.TODO(),
+			&sns.SetSubscriptionAttributesInput{
+				SubscriptionArn: aws.String(subscriptionArn),
+				AttributeName:   aws.String("FilterPolicyScope"),
+				AttributeValue:  aws.String(scope),
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := s.snsClient.SetSubscriptionAttributes(
+		context.TODO(),
+		&sns.SetSubscriptionAttributesInput{
+			SubscriptionArn: aws.String(subscriptionArn),
+			AttributeName:   aws.String("FilterPolicy"),
+			AttributeValue:  aws.String(policy),
+		},
+	)
+	return err
+}
+
 func (s SNS) ListTags(topicArn string) (model.Tags, error) {
 	out, err := s.snsClient.ListTagsForResource(
 		context.TODO(),
@@ -2,11 +2,14 @@ package repo
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/bporter816/aws-tui/internal/model"
+	"strconv"
 )
 
 type SQS struct {
@@ -33,6 +36,43 @@ func (s SQS) getAttributes(queueUrl string, attributeNames []sqsTypes.QueueAttri
 	return out.Attributes, nil
 }
 
+// Internal function to build a queue from its attributes
+func parseQueue(queueUrl string, attrs map[string]string) model.SQSQueue {
+	queue := model.SQSQueue{QueueUrl: queueUrl}
+	// get the ARN and pull the name out
+	if queueArn, ok := attrs[string(sqsTypes.QueueAttributeNameQueueArn)]; ok {
+		queue.Arn = queueArn
+		arn, err := arn.Parse(queueArn)
+		if err == nil {
+			queue.Name = arn.Resource
+		}
+	}
+	if isFifo, ok := attrs[string(sqsTypes.QueueAttributeNameFifoQueue)]; ok && isFifo == "true" {
+		queue.IsFifo = true
+	}
+	parseInt := func(name sqsTypes.QueueAttributeName) int64 {
+		v, _ := strconv.ParseInt(attrs[string(name)], 10, 64)
+		return v
+	}
+	queue.MessagesAvailable = parseInt(sqsTypes.QueueAttributeNameApproximateNumberOfMessages)
+	queue.MessagesInFlight = parseInt(sqsTypes.QueueAttributeNameApproximateNumberOfMessagesNotVisible)
+	queue.MessagesDelayed = parseInt(sqsTypes.QueueAttributeNameApproximateNumberOfMessagesDelayed)
+	queue.VisibilityTimeoutSeconds = parseInt(sqsTypes.QueueAttributeNameVisibilityTimeout)
+	queue.RetentionPeriodSeconds = parseInt(sqsTypes.QueueAttributeNameMessageRetentionPeriod)
+	if redrivePolicy, ok := attrs[string(sqsTypes.QueueAttributeNameRedrivePolicy)]; ok {
+		// maxReceiveCount can be either a number or a string
+		var policy struct {
+			DeadLetterTargetArn string      `json:"deadLetterTargetArn"`
+			MaxReceiveCount     json.Number `json:"maxReceiveCount"`
+		}
+		if err := json.Unmarshal([]byte(redrivePolicy), &policy); err == nil {
+			queue.DeadLetterTargetArn = policy.DeadLetterTargetArn
+			queue.MaxReceiveCount, _ = policy.MaxReceiveCount.Int64()
+		}
+	}
+	return queue
+}
+
 func (s SQS) ListQueues() ([]model.SQSQueue, error) {
 	var queues []model.SQSQueue
 	pg := sqs.NewListQueuesPaginator(
@@ -49,17 +89,133 @@ func (s SQS) ListQueues() ([]model.SQSQueue, error) {
 			if err != nil {
 				continue
 			}
-			var queue model.SQSQueue
-			// get the ARN and pull the name out
-			if queueArn, ok := attrs[string(sqsTypes.QueueAttributeNameQueueArn)]; ok {
-				arn, err := arn.Parse(queueArn)
-				if err == nil {
-					queue.Name = arn.Resource
-				}
+			queues = append(queues, parseQueue(v, attrs))
+		}
+	}
+	return queues, nil
+}
+
+func (s SQS) GetQueue(queueUrl string) (model.SQSQueue, error) {
+	attrs, err := s.getAttributes(queueUrl, []sqsTypes.QueueAttributeName{sqsTypes.QueueAttributeNameAll})
+	if err != nil {
+		return model.SQSQueue{}, err
+	}
+	return parseQueue(queueUrl, attrs), nil
+}
+
+// PeekMessages receives messages and immediately resets their visibility timeout to zero so they become visible to
+// consumers again. Each receive still counts towards the redrive policy's max receive count.
+func (s SQS) PeekMessages(queueUrl string) ([]model.SQSMessage, error) {
+	var messages []model.SQSMessage
+	indexes := make(map[string]int)
+	// short polling only samples a subset of servers, so make a few requests
+	for i := 0; i < 3; i++ {
+		out, err := s.sqsClient.ReceiveMessage(
+			context.TODO(),
+			&sqs.ReceiveMessageInput{
+				QueueUrl:                    aws.String(queueUrl),
+				MaxNumberOfMessages:         10,
+				MessageAttributeNames:       []string{"All"},
+				MessageSystemAttributeNames: []sqsTypes.MessageSystemAttributeName{sqsTypes.MessageSystemAttributeNameAll},
+			},
+		)
+		if err != nil {
+			return []model.SQSMessage{}, err
+		}
+		if err := s.resetVisibility(queueUrl, out.Messages); err != nil {
+			return []model.SQSMessage{}, err
+		}
+		for _, v := range out.Messages {
+			if v.MessageId == nil {
+				continue
 			}
-			queue.QueueUrl = v
-			if isFifo, ok := attrs[string(sqsTypes.QueueAttributeNameFifoQueue)]; ok && isFifo == "true" {
-				queue.IsFifo = true
+			// keep the latest receipt handle, since older ones may no longer be accepted
+			if index, ok := indexes[*v.MessageId]; ok {
+				messages[index].ReceiptHandle = v.ReceiptHandle
+				continue
+			}
+			indexes[*v.MessageId] = len(messages)
+			messages = append(messages, model.SQSMessage(v))
+		}
+	}
+	return messages, nil
+}
+
+// resetVisibility makes received messages visible again. A zero VisibilityTimeout on ReceiveMessage is omitted by the
+// SDK, so the queue's default timeout would apply otherwise.
+func (s SQS) resetVisibility(queueUrl string, messages []sqsTypes.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	var entries []sqsTypes.ChangeMessageVisibilityBatchRequestEntry
+	for i, v := range messages {
+		entries = append(entries, sqsTypes.ChangeMessageVisibilityBatchRequestEntry{
+			Id:                aws.String(strconv.Itoa(i)),
+			ReceiptHandle:     v.ReceiptHandle,
+			VisibilityTimeout: 0,
+		})
+	}
+	out, err := s.sqsClient.ChangeMessageVisibilityBatch(
+		context.TODO(),
+		&sqs.ChangeMessageVisibilityBatchInput{
+			QueueUrl: aws.String(queueUrl),
+			Entries:  entries,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if len(out.Failed) > 0 {
+		return fmt.Errorf("failed to reset visibility of %v messages: %v", len(out.Failed), aws.ToString(out.Failed[0].Message))
+	}
+	return nil
+}
+
+func (s SQS) SendMessage(queueUrl string, body string, messageGroupId string, deduplicationId string) (string, error) {
+	in := &sqs.SendMessageInput{
+		QueueUrl:    aws.String(queueUrl),
+		MessageBody: aws.String(body),
+	}
+	if messageGroupId != "" {
+		in.MessageGroupId = aws.String(messageGroupId)
+	}
+	if deduplicationId != "" {
+		in.MessageDeduplicationId = aws.String(deduplicationId)
+	}
+	out, err := s.sqsClient.SendMessage(context.TODO(), in)
+	if err != nil {
+		return "", err
+	}
+	return *out.MessageId, nil
+}
+
+func (s SQS) PurgeQueue(queueUrl string) error {
+	_, err := s.sqsClient.PurgeQueue(
+		context.TODO(),
+		&sqs.PurgeQueueInput{
+			QueueUrl: aws.String(queueUrl),
+		},
+	)
+	return err
+}
+
+func (s SQS) ListDeadLetterSourceQueues(queueUrl string) ([]model.SQSQueue, error) {
+	var queues []model.SQSQueue
+	pg := sqs.NewListDeadLetterSourceQueuesPaginator(
+		s.sqsClient,
+		&sqs.ListDeadLetterSourceQueuesInput{
+			QueueUrl: aws.String(queueUrl),
+		},
+	)
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.SQSQueue{}, err
+		}
+		for _, v := range out.QueueUrls {
+			queue, err := s.GetQueue(v)
+			if err != nil {
+				return []model.SQSQueue{}, err
 			}
 			queues = append(queues, queue)
 		}
@@ -67,6 +223,125 @@ func (s SQS) ListQueues() ([]model.SQSQueue, error) {
 	return queues, nil
 }
 
+// receiveMessage receives until it finds the given message and returns a current receipt handle for it. Other
+// messages received along the way are made visible again.
+func (s SQS) receiveMessage(queueUrl string, messageId string) (*string, error) {
+	for i := 0; i < 3; i++ {
+		out, err := s.sqsClient.ReceiveMessage(
+			context.TODO(),
+			&sqs.ReceiveMessageInput{
+				QueueUrl:            aws.String(queueUrl),
+				MaxNumberOfMessages: 10,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		var receiptHandle *string
+		var others []sqsTypes.Message
+		for _, v := range out.Messages {
+			if aws.ToString(v.MessageId) == messageId {
+				receiptHandle = v.ReceiptHandle
+			} else {
+				others = append(others, v)
+			}
+		}
+		if err := s.resetVisibility(queueUrl, others); err != nil {
+			return nil, err
+		}
+		if receiptHandle != nil {
+			return receiptHandle, nil
+		}
+	}
+	return nil, fmt.Errorf("message %v was not received", messageId)
+}
+
+// MoveMessage sends a copy of a peeked message to another queue, then deletes the original. The peeked receipt handle
+// is stale by then, so the original is received again to delete it.
+func (s SQS) MoveMessage(message model.SQSMessage, fromQueueUrl string, toQueue model.SQSQueue) error {
+	in := &sqs.SendMessageInput{
+		QueueUrl:          aws.String(toQueue.QueueUrl),
+		MessageBody:       message.Body,
+		MessageAttributes: message.MessageAttributes,
+	}
+	if toQueue.IsFifo {
+		groupId, ok := message.Attributes[string(sqsTypes.MessageSystemAttributeNameMessageGroupId)]
+		if !ok {
+			return fmt.Errorf("message has no group id to send to FIFO queue %v", toQueue.Name)
+		}
+		dedupId, ok := message.Attributes[string(sqsTypes.MessageSystemAttributeNameMessageDeduplicationId)]
+		if !ok {
+			dedupId = *message.MessageId
+		}
+		in.MessageGroupId = aws.String(groupId)
+		in.MessageDeduplicationId = aws.String(dedupId)
+	}
+	if _, err := s.sqsClient.SendMessage(context.TODO(), in); err != nil {
+		return err
+	}
+	receiptHandle, err := s.receiveMessage(fromQueueUrl, aws.ToString(message.MessageId))
+	if err == nil {
+		_, err = s.sqsClient.DeleteMessage(
+			context.TODO(),
+			&sqs.DeleteMessageInput{
+				QueueUrl:      aws.String(fromQueueUrl),
+				ReceiptHandle: receiptHandle,
+			},
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("message was copied to %v but not deleted from the source queue: %w", toQueue.Name, err)
+	}
+	return nil
+}
+
+func (s SQS) ListMessageMoveTasks(sourceArn string) ([]model.SQSMessageMoveTask, error) {
+	out, err := s.sqsClient.ListMessageMoveTasks(
+		context.TODO(),
+		&sqs.ListMessageMoveTasksInput{
+			SourceArn:  aws.String(sourceArn),
+			MaxResults: aws.Int32(10),
+		},
+	)
+	if err != nil {
+		return []model.SQSMessageMoveTask{}, err
+	}
+	var tasks []model.SQSMessageMoveTask
+	for _, v := range out.Results {
+		tasks = append(tasks, model.SQSMessageMoveTask(v))
+	}
+	return tasks, nil
+}
+
+// StartMessageMoveTask moves messages from a DLQ. An empty destination moves them back to their original source
+// queues, and a zero rate lets SQS pick the rate.
+func (s SQS) StartMessageMoveTask(sourceArn string, destinationArn string, maxPerSecond int32) (string, error) {
+	in := &sqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(sourceArn),
+	}
+	if destinationArn != "" {
+		in.DestinationArn = aws.String(destinationArn)
+	}
+	if maxPerSecond > 0 {
+		in.MaxNumberOfMessagesPerSecond = aws.Int32(maxPerSecond)
+	}
+	out, err := s.sqsClient.StartMessageMoveTask(context.TODO(), in)
+	if err != nil {
+		return "", err
+	}
+	return *out.TaskHandle, nil
+}
+
+func (s SQS) CancelMessageMoveTask(taskHandle string) error {
+	_, err := s.sqsClient.CancelMessageMoveTask(
+		context.TODO(),
+		&sqs.CancelMessageMoveTaskInput{
+			TaskHandle: aws.String(taskHandle),
+		},
+	)
+	return err
+}
+
 func (s SQS) GetAccessPolicy(queueUrl string) (string, error) {
 	attrs, err := s.getAttributes(queueUrl, []sqsTypes.QueueAttributeName{sqsTypes.QueueAttributeNamePolicy})
 	if err != nil {
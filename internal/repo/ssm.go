@@ -39,6 +39,110 @@ func (s SSM) ListParameters() ([]model.SSMParameter, error) {
 	return parameters, nil
 }
 
+// GetParameter gets a parameter's value. SecureString values are only decrypted if withDecryption is set.
+func (s SSM) GetParameter(name string, withDecryption bool) (model.SSMParameterValue, error) {
+	out, err := s.ssmClient.GetParameter(
+		context.TODO(),
+		&ssm.GetParameterInput{
+			Name:           aws.String(name),
+			WithDecryption: aws.Bool(withDecryption),
+		},
+	)
+	if err != nil {
+		return model.SSMParameterValue{}, err
+	}
+	return model.SSMParameterValue(*out.Parameter), nil
+}
+
+func (s SSM) DescribeParameter(name string) (model.SSMParameter, error) {
+	out, err := s.ssmClient.DescribeParameters(
+		context.TODO(),
+		&ssm.DescribeParametersInput{
+			ParameterFilters: []ssmTypes.ParameterStringFilter{
+				{
+					Key:    aws.String("Name"),
+					Option: aws.String("Equals"),
+					Values: []string{name},
+				},
+			},
+		},
+	)
+	if err != nil {
+		return model.SSMParameter{}, err
+	}
+	if len(out.Parameters) == 0 {
+		return model.SSMParameter{}, errors.New("parameter not found")
+	}
+	return model.SSMParameter(out.Parameters[0]), nil
+}
+
+// ListParametersByPath gets the parameters under a path without decrypting SecureString values
+func (s SSM) ListParametersByPath(path string, recursive bool) ([]model.SSMParameterValue, error) {
+	pg := ssm.NewGetParametersByPathPaginator(
+		s.ssmClient,
+		&ssm.GetParametersByPathInput{
+			Path:           aws.String(path),
+			Recursive:      aws.Bool(recursive),
+			WithDecryption: aws.Bool(false),
+		},
+	)
+	var parameters []model.SSMParameterValue
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.SSMParameterValue{}, err
+		}
+		for _, v := range out.Parameters {
+			parameters = append(parameters, model.SSMParameterValue(v))
+		}
+	}
+	return parameters, nil
+}
+
+func (s SSM) GetParameterHistory(name string, withDecryption bool) ([]model.SSMParameterHistory, error) {
+	pg := ssm.NewGetParameterHistoryPaginator(
+		s.ssmClient,
+		&ssm.GetParameterHistoryInput{
+			Name:           aws.String(name),
+			WithDecryption: aws.Bool(withDecryption),
+		},
+	)
+	var history []model.SSMParameterHistory
+	for pg.HasMorePages() {
+		out, err := pg.NextPage(context.TODO())
+		if err != nil {
+			return []model.SSMParameterHistory{}, err
+		}
+		for _, v := range out.Parameters {
+			history = append(history, model.SSMParameterHistory(v))
+		}
+	}
+	return history, nil
+}
+
+// PutParameter creates or overwrites a parameter. The KMS key is only used for SecureString parameters,
+// and an empty description leaves the existing one unchanged.
+func (s SSM) PutParameter(name string, value string, description string, parameterType ssmTypes.ParameterType, tier ssmTypes.ParameterTier, keyId string, overwrite bool) (int64, error) {
+	in := &ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Value:     aws.String(value),
+		Type:      parameterType,
+		Tier:      tier,
+		Overwrite: aws.Bool(overwrite),
+	}
+	if description != "" {
+		in.Description = aws.String(description)
+	}
+	if parameterType == ssmTypes.ParameterTypeSecureString && keyId != "" {
+		in.KeyId = aws.String(keyId)
+	}
+	out, err := s.ssmClient.PutParameter(context.TODO(), in)
+	if err != nil {
+		return 0, err
+	}
+	return out.Version, nil
+}
+
 func (s SSM) ListTags(resourceId string) (model.Tags, error) {
 	parts := strings.Split(resourceId, ":")
 	if len(parts) != 2 {
@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+)
+
+const (
+	route53ChangePollInterval = 5 * time.Second
+	// how long an INSYNC status stays in the footer before it is cleared
+	route53ChangeInSyncDisplay = 10 * time.Second
+)
+
+// trackRoute53Change polls a submitted change in the background until Route 53 has propagated it to all of its
+// authoritative name servers, showing the status in the footer
+func trackRoute53Change(repo *repo.Route53, changeId string, app *Application) {
+	id := strings.TrimPrefix(changeId, "/change/")
+	setStatus := func(status string) {
+		app.app.QueueUpdateDraw(func() {
+			app.footer.SetStatus(id, status)
+		})
+	}
+
+	go func() {
+		for {
+			changeInfo, err := repo.GetChange(changeId)
+			if err != nil {
+				setStatus(fmt.Sprintf("Route 53 change %v: %v", id, err))
+				break
+			}
+			setStatus(fmt.Sprintf("Route 53 change %v: %v", id, changeInfo.Status))
+			if changeInfo.Status == r53Types.ChangeStatusInsync {
+				break
+			}
+			time.Sleep(route53ChangePollInterval)
+		}
+		time.Sleep(route53ChangeInSyncDisplay)
+		setStatus("")
+	}()
+}
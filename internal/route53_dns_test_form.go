@@ -0,0 +1,210 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const dnsLookupTimeout = 10 * time.Second
+
+type Route53DNSTestForm struct {
+	*tview.Form
+	view.Route53
+	repo         *repo.Route53
+	hostedZoneId string
+	settings     *settings.Settings
+	app          *Application
+}
+
+func NewRoute53DNSTestForm(repo *repo.Route53, hostedZoneId, recordName, recordType string, settings *settings.Settings, app *Application) *Route53DNSTestForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Test DNS Answer ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	r := &Route53DNSTestForm{
+		Form:         form,
+		repo:         repo,
+		hostedZoneId: hostedZoneId,
+		settings:     settings,
+		app:          app,
+	}
+
+	var recordTypes []string
+	selected := 0
+	for i, v := range r53Types.RRType("").Values() {
+		recordTypes = append(recordTypes, string(v))
+		if string(v) == recordType {
+			selected = i
+		}
+	}
+
+	form.AddInputField("Record Name", recordName, 60, nil, nil)
+	form.AddDropDown("Type", recordTypes, selected, nil)
+	form.AddInputField("Resolver IP", "", 40, nil, nil)
+	form.AddInputField("EDNS Client Subnet", "", 40, nil, nil)
+	form.AddInputField("Local Nameserver", settings.DNSNameserver, 40, nil, nil)
+	form.AddButton("Test", r.testHandler)
+	form.AddButton("Cancel", r.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return r
+}
+
+// parseClientSubnet splits an EDNS client subnet like 192.0.2.0/24 into the IP and mask Route 53 expects
+func parseClientSubnet(subnet string) (string, string, error) {
+	if subnet == "" {
+		return "", "", nil
+	}
+	if !strings.Contains(subnet, "/") {
+		if net.ParseIP(subnet) == nil {
+			return "", "", fmt.Errorf("%v is not a valid IP address or CIDR block", subnet)
+		}
+		return subnet, "", nil
+	}
+	ip, ipNet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return "", "", fmt.Errorf("%v is not a valid IP address or CIDR block", subnet)
+	}
+	ones, _ := ipNet.Mask.Size()
+	return ip.String(), strconv.Itoa(ones), nil
+}
+
+// lookupDNS resolves a record with Go's resolver, using the given nameserver instead of the system one if set
+func lookupDNS(nameserver, name, recordType string) ([]string, error) {
+	resolver := net.DefaultResolver
+	if nameserver != "" {
+		address := nameserver
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			address = net.JoinHostPort(address, "53")
+		}
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, address)
+			},
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), dnsLookupTimeout)
+	defer cancel()
+
+	var values []string
+	var err error
+	switch recordType {
+	case "A", "AAAA":
+		network := "ip4"
+		if recordType == "AAAA" {
+			network = "ip6"
+		}
+		var ips []net.IP
+		ips, err = resolver.LookupIP(ctx, network, name)
+		for _, v := range ips {
+			values = append(values, v.String())
+		}
+	case "CNAME":
+		var cname string
+		cname, err = resolver.LookupCNAME(ctx, name)
+		// names without a CNAME resolve to themselves
+		if err == nil && !strings.EqualFold(strings.TrimSuffix(cname, "."), strings.TrimSuffix(name, ".")) {
+			values = append(values, cname)
+		}
+	case "MX":
+		var mxs []*net.MX
+		mxs, err = resolver.LookupMX(ctx, name)
+		for _, v := range mxs {
+			values = append(values, fmt.Sprintf("%v %v", v.Pref, v.Host))
+		}
+	case "NS":
+		var nss []*net.NS
+		nss, err = resolver.LookupNS(ctx, name)
+		for _, v := range nss {
+			values = append(values, v.Host)
+		}
+	case "SRV":
+		var srvs []*net.SRV
+		_, srvs, err = resolver.LookupSRV(ctx, "", "", name)
+		for _, v := range srvs {
+			values = append(values, fmt.Sprintf("%v %v %v %v", v.Priority, v.Weight, v.Port, v.Target))
+		}
+	case "TXT", "SPF":
+		values, err = resolver.LookupTXT(ctx, name)
+	default:
+		return nil, fmt.Errorf("local lookups of %v records are not supported", recordType)
+	}
+
+	// a name that doesn't exist is an empty answer rather than a failed lookup
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return []string{}, nil
+	}
+	return values, err
+}
+
+func (r *Route53DNSTestForm) testHandler() {
+	recordName := strings.TrimSpace(r.GetFormItem(0).(*tview.InputField).GetText())
+	_, recordType := r.GetFormItem(1).(*tview.DropDown).GetCurrentOption()
+	resolverIP := strings.TrimSpace(r.GetFormItem(2).(*tview.InputField).GetText())
+	clientSubnet := strings.TrimSpace(r.GetFormItem(3).(*tview.InputField).GetText())
+	nameserver := strings.TrimSpace(r.GetFormItem(4).(*tview.InputField).GetText())
+
+	if recordName == "" {
+		r.app.ShowError(r.GetService(), "Record name is required")
+		return
+	}
+	if resolverIP != "" && net.ParseIP(resolverIP) == nil {
+		r.app.ShowError(r.GetService(), resolverIP+" is not a valid IP address")
+		return
+	}
+	subnetIP, subnetMask, err := parseClientSubnet(clientSubnet)
+	if err != nil {
+		r.app.ShowError(r.GetService(), err.Error())
+		return
+	}
+	if err := r.settings.SetDNSNameserver(nameserver); err != nil {
+		r.app.ShowError(r.GetService(), fmt.Sprintf("Failed to save nameserver: %v", err))
+		return
+	}
+
+	answer, err := r.repo.TestDNSAnswer(r.hostedZoneId, recordName, r53Types.RRType(recordType), resolverIP, subnetIP, subnetMask)
+	if err != nil {
+		r.app.ShowError(r.GetService(), fmt.Sprintf("Test DNS answer failed: %v", err))
+		return
+	}
+	localAnswer, localErr := lookupDNS(nameserver, recordName, recordType)
+
+	resultView := NewRoute53DNSTestResult(recordName, recordType, answer, nameserver, localAnswer, localErr, r.app)
+	r.app.AddAndSwitch(resultView)
+}
+
+func (r *Route53DNSTestForm) cancelHandler() {
+	r.app.Close()
+}
+
+func (r Route53DNSTestForm) GetLabels() []string {
+	return []string{r.hostedZoneId, "Test DNS"}
+}
+
+func (r Route53DNSTestForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (r Route53DNSTestForm) Render() {
+}
@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type Route53DNSTestResult struct {
+	*ui.Text
+	view.Route53
+	recordName  string
+	recordType  string
+	answer      model.Route53DNSAnswer
+	nameserver  string
+	localAnswer []string
+	localErr    error
+	app         *Application
+}
+
+func NewRoute53DNSTestResult(recordName, recordType string, answer model.Route53DNSAnswer, nameserver string, localAnswer []string, localErr error, app *Application) *Route53DNSTestResult {
+	r := &Route53DNSTestResult{
+		Text:        ui.NewText(false, ""),
+		recordName:  recordName,
+		recordType:  recordType,
+		answer:      answer,
+		nameserver:  nameserver,
+		localAnswer: localAnswer,
+		localErr:    localErr,
+		app:         app,
+	}
+	return r
+}
+
+func (r Route53DNSTestResult) GetLabels() []string {
+	return []string{r.recordName, r.recordType, "Test Result"}
+}
+
+func (r Route53DNSTestResult) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func writeDNSAnswer(sb *strings.Builder, values []string) {
+	if len(values) == 0 {
+		sb.WriteString("  <no records>\n")
+	}
+	for _, v := range values {
+		sb.WriteString("  " + v + "\n")
+	}
+}
+
+func (r Route53DNSTestResult) Render() {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(
+		"Route 53 answer from %v over %v: %v\n",
+		utils.DerefString(r.answer.Nameserver, "-"),
+		utils.DerefString(r.answer.Protocol, "-"),
+		utils.DerefString(r.answer.ResponseCode, "-"),
+	))
+	writeDNSAnswer(&sb, r.answer.RecordData)
+
+	source := "the system resolver"
+	if r.nameserver != "" {
+		source = r.nameserver
+	}
+	sb.WriteString("\nLocal answer from " + source + ":\n")
+	if r.localErr != nil {
+		sb.WriteString("  Lookup failed: " + r.localErr.Error() + "\n")
+		sb.WriteString("\nResult: Unable to compare\n")
+		r.SetText(sb.String())
+		return
+	}
+	writeDNSAnswer(&sb, r.localAnswer)
+
+	onlyRoute53, onlyLocal := utils.CompareDNSAnswers(r.recordType, r.answer.RecordData, r.localAnswer)
+	if len(onlyRoute53) == 0 && len(onlyLocal) == 0 {
+		sb.WriteString("\nResult: Match\n")
+	} else {
+		sb.WriteString("\nResult: MISMATCH, the change may not have propagated or a resolver is caching an old answer\n")
+		if len(onlyRoute53) > 0 {
+			sb.WriteString("\nOnly in the Route 53 answer:\n")
+			writeDNSAnswer(&sb, onlyRoute53)
+		}
+		if len(onlyLocal) > 0 {
+			sb.WriteString("\nOnly in the local answer:\n")
+			writeDNSAnswer(&sb, onlyLocal)
+		}
+		if r.recordType != "CNAME" {
+			sb.WriteString("\nNote: the local resolver follows CNAME chains, so names that are CNAMEs will not match.\n")
+		}
+	}
+	r.SetText(sb.String())
+}
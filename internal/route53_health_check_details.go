@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+// Route53HealthCheckDetails shows the latest observation from each health checker next to its last failure
+type Route53HealthCheckDetails struct {
+	*ui.Table
+	view.Route53
+	repo          *repo.Route53
+	healthCheckId string
+	app           *Application
+	health        string
+}
+
+func NewRoute53HealthCheckDetails(repo *repo.Route53, healthCheckId string, app *Application) *Route53HealthCheckDetails {
+	r := &Route53HealthCheckDetails{
+		Table: ui.NewTable([]string{
+			"REGION",
+			"IP ADDRESS",
+			"STATUS",
+			"CHECKED",
+			"LAST FAILURE",
+			"FAILED",
+		}, 1, 0),
+		repo:          repo,
+		healthCheckId: healthCheckId,
+		app:           app,
+	}
+	return r
+}
+
+func (r Route53HealthCheckDetails) GetLabels() []string {
+	if r.health != "" {
+		return []string{r.healthCheckId, "Details", r.health}
+	}
+	return []string{r.healthCheckId, "Details"}
+}
+
+func (r Route53HealthCheckDetails) recordsHandler() {
+	recordsView := NewRoute53HealthCheckRecords(r.repo, r.healthCheckId, r.app)
+	r.app.AddAndSwitch(recordsView)
+}
+
+func (r Route53HealthCheckDetails) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone),
+			Description: "Records",
+			Action:      r.recordsHandler,
+		},
+	}
+}
+
+func toHealthCheckObservations(observations []model.Route53HealthCheckObservation) []r53Types.HealthCheckObservation {
+	var ret []r53Types.HealthCheckObservation
+	for _, v := range observations {
+		ret = append(ret, r53Types.HealthCheckObservation(v))
+	}
+	return ret
+}
+
+func getHealthCheckObservationKey(v model.Route53HealthCheckObservation) string {
+	return string(v.Region) + " " + utils.DerefString(v.IPAddress, "")
+}
+
+func formatStatusReport(v *r53Types.StatusReport) (string, string) {
+	if v == nil {
+		return "", ""
+	}
+	var checked string
+	if v.CheckedTime != nil {
+		checked = v.CheckedTime.Format(utils.DefaultTimeFormat)
+	}
+	return utils.DerefString(v.Status, ""), checked
+}
+
+func (r *Route53HealthCheckDetails) Render() {
+	observations, err := r.repo.GetHealthCheckStatus(r.healthCheckId)
+	if err != nil {
+		panic(err)
+	}
+	failures, err := r.repo.GetHealthCheckLastFailureReason(r.healthCheckId)
+	if err != nil {
+		panic(err)
+	}
+
+	health, healthy := utils.GetRoute53HealthCheckHealth(toHealthCheckObservations(observations))
+	r.health = fmt.Sprintf("%v (%v/%v)", health, healthy, len(observations))
+
+	failuresByChecker := make(map[string]model.Route53HealthCheckObservation)
+	for _, v := range failures {
+		failuresByChecker[getHealthCheckObservationKey(v)] = v
+	}
+	sort.Slice(observations, func(i, j int) bool {
+		return getHealthCheckObservationKey(observations[i]) < getHealthCheckObservationKey(observations[j])
+	})
+
+	var data [][]string
+	for _, v := range observations {
+		status, checked := formatStatusReport(v.StatusReport)
+		var lastFailure, failed string
+		if failure, ok := failuresByChecker[getHealthCheckObservationKey(v)]; ok {
+			lastFailure, failed = formatStatusReport(failure.StatusReport)
+		}
+		data = append(data, []string{
+			string(v.Region),
+			utils.DerefString(v.IPAddress, ""),
+			status,
+			checked,
+			lastFailure,
+			failed,
+		})
+	}
+	r.SetData(data)
+}
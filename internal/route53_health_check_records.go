@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+// Route53HealthCheckRecords lists the records across all hosted zones that are associated with a health check
+type Route53HealthCheckRecords struct {
+	*ui.Table
+	view.Route53
+	repo          *repo.Route53
+	healthCheckId string
+	app           *Application
+}
+
+func NewRoute53HealthCheckRecords(repo *repo.Route53, healthCheckId string, app *Application) *Route53HealthCheckRecords {
+	r := &Route53HealthCheckRecords{
+		Table: ui.NewTable([]string{
+			"HOSTED ZONE",
+			"RECORD NAME",
+			"TYPE",
+			"LABEL",
+			"VALUE",
+		}, 1, 0),
+		repo:          repo,
+		healthCheckId: healthCheckId,
+		app:           app,
+	}
+	return r
+}
+
+func (r Route53HealthCheckRecords) GetLabels() []string {
+	return []string{r.healthCheckId, "Records"}
+}
+
+func (r Route53HealthCheckRecords) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (r Route53HealthCheckRecords) Render() {
+	model, err := r.repo.ListHealthCheckRecords(r.healthCheckId)
+	if err != nil {
+		panic(err)
+	}
+
+	var data [][]string
+	for _, v := range model {
+		var value string
+		if v.Record.AliasTarget != nil {
+			value = utils.DerefString(v.Record.AliasTarget.DNSName, "")
+		} else {
+			value = utils.FormatRoute53ResourceRecords(v.Record.ResourceRecords)
+		}
+		data = append(data, []string{
+			strings.TrimSuffix(utils.DerefString(v.HostedZone.Name, ""), "."),
+			strings.TrimSuffix(utils.UnescapeRoute53Name(utils.DerefString(v.Record.Name, "")), "."),
+			string(v.Record.Type),
+			utils.DerefString(v.Record.SetIdentifier, "-"),
+			value,
+		})
+	}
+	r.SetData(data)
+}
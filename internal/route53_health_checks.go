@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"fmt"
+
 	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/bporter816/aws-tui/internal/repo"
 	"github.com/bporter816/aws-tui/internal/ui"
@@ -23,6 +25,8 @@ func NewRoute53HealthChecks(repo *repo.Route53, app *Application) *Route53Health
 			"NAME",
 			"TYPE",
 			"DESCRIPTION",
+			"HEALTH",
+			"CHECKERS",
 		}, 1, 0),
 		repo: repo,
 		app:  app,
@@ -43,8 +47,36 @@ func (r Route53HealthChecks) tagsHandler() {
 	r.app.AddAndSwitch(tagsView)
 }
 
+func (r Route53HealthChecks) detailsHandler() {
+	healthCheckId, err := r.GetColSelection("ID")
+	if err != nil {
+		return
+	}
+	detailsView := NewRoute53HealthCheckDetails(r.repo, healthCheckId, r.app)
+	r.app.AddAndSwitch(detailsView)
+}
+
+func (r Route53HealthChecks) recordsHandler() {
+	healthCheckId, err := r.GetColSelection("ID")
+	if err != nil {
+		return
+	}
+	recordsView := NewRoute53HealthCheckRecords(r.repo, healthCheckId, r.app)
+	r.app.AddAndSwitch(recordsView)
+}
+
 func (r Route53HealthChecks) GetKeyActions() []KeyAction {
 	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'd', tcell.ModNone),
+			Description: "Details",
+			Action:      r.detailsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone),
+			Description: "Records",
+			Action:      r.recordsHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
@@ -62,9 +94,17 @@ func (r Route53HealthChecks) Render() {
 	var data [][]string
 	for _, v := range model {
 		var id, name, checkType, description string
+		health, checkers := "-", "-"
 		if v.Id != nil {
 			id = *v.Id
 
+			// health checks that aren't checked by Route 53's checkers (like recovery control ones) have no status
+			if observations, err := r.repo.GetHealthCheckStatus(*v.Id); err == nil && len(observations) > 0 {
+				var healthy int
+				health, healthy = utils.GetRoute53HealthCheckHealth(toHealthCheckObservations(observations))
+				checkers = fmt.Sprintf("%v/%v healthy", healthy, len(observations))
+			}
+
 			// name comes from the Name tag
 			tags, err := r.repo.ListTags(string(r53Types.TagResourceTypeHealthcheck) + ":" + *v.Id)
 			if err != nil {
@@ -83,6 +123,8 @@ func (r Route53HealthChecks) Render() {
 			name,
 			checkType,
 			description,
+			health,
+			checkers,
 		})
 	}
 	r.SetData(data)
@@ -6,6 +6,7 @@ import (
 
 	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
 	"github.com/bporter816/aws-tui/internal/view"
@@ -15,11 +16,12 @@ import (
 type Route53HostedZones struct {
 	*ui.Table
 	view.Route53
-	repo *repo.Route53
-	app  *Application
+	repo     *repo.Route53
+	settings *settings.Settings
+	app      *Application
 }
 
-func NewRoute53HostedZones(repo *repo.Route53, app *Application) *Route53HostedZones {
+func NewRoute53HostedZones(repo *repo.Route53, settings *settings.Settings, app *Application) *Route53HostedZones {
 	r := &Route53HostedZones{
 		Table: ui.NewTable([]string{
 			"ID",
@@ -28,8 +30,9 @@ func NewRoute53HostedZones(repo *repo.Route53, app *Application) *Route53HostedZ
 			"VISIBILITY",
 			"DESCRIPTION",
 		}, 1, 0),
-		repo: repo,
-		app:  app,
+		repo:     repo,
+		settings: settings,
+		app:      app,
 	}
 	r.SetSelectedFunc(r.selectHandler)
 	return r
@@ -48,7 +51,7 @@ func (r Route53HostedZones) selectHandler(row, col int) {
 	if err != nil {
 		return
 	}
-	recordsView := NewRoute53Records(r.repo, hostedZoneId, hostedZoneName, r.app)
+	recordsView := NewRoute53Records(r.repo, hostedZoneId, hostedZoneName, r.settings, r.app)
 	r.app.AddAndSwitch(recordsView)
 }
 
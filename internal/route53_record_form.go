@@ -22,9 +22,10 @@ type Route53RecordForm struct {
 	mode         string // "create", "update", or "delete"
 	existingRecord *r53Types.ResourceRecordSet
 	onComplete   func()
+	onStage      func(changes []r53Types.Change) // queues the edit instead of submitting it, if set
 }
 
-func NewRoute53RecordForm(repo *repo.Route53, hostedZoneId, hostedZoneName, mode string, existingRecord *r53Types.ResourceRecordSet, app *Application, onComplete func()) *Route53RecordForm {
+func NewRoute53RecordForm(repo *repo.Route53, hostedZoneId, hostedZoneName, mode string, existingRecord *r53Types.ResourceRecordSet, app *Application, onComplete func(), onStage func(changes []r53Types.Change)) *Route53RecordForm {
 	form := tview.NewForm()
 
 	r := &Route53RecordForm{
@@ -36,6 +37,7 @@ func NewRoute53RecordForm(repo *repo.Route53, hostedZoneId, hostedZoneName, mode
 		mode:           mode,
 		existingRecord: existingRecord,
 		onComplete:     onComplete,
+		onStage:        onStage,
 	}
 
 	r.buildForm()
@@ -116,6 +118,9 @@ func (r *Route53RecordForm) buildForm() {
 		r.Form.AddTextView("TTL:", recordTTL, 0, 1, false, false)
 		r.Form.AddTextView("Value:", recordValue, 0, 5, false, false)
 		r.Form.AddButton("Delete", r.deleteHandler)
+		if r.onStage != nil {
+			r.Form.AddButton("Stage", r.stageHandler)
+		}
 		r.Form.AddButton("Cancel", r.cancelHandler)
 	} else {
 		// Create/Update mode: editable fields with zone suffix displayed
@@ -141,6 +146,9 @@ func (r *Route53RecordForm) buildForm() {
 		} else {
 			r.Form.AddButton("Update", r.updateHandler)
 		}
+		if r.onStage != nil {
+			r.Form.AddButton("Stage", r.stageHandler)
+		}
 		r.Form.AddButton("Cancel", r.cancelHandler)
 	}
 
@@ -173,7 +181,8 @@ func (r *Route53RecordForm) buildForm() {
 	})
 }
 
-func (r *Route53RecordForm) createHandler() {
+// buildRecordSet reads the record set from the form, showing an error if it is invalid
+func (r *Route53RecordForm) buildRecordSet() (r53Types.ResourceRecordSet, bool) {
 	recordName := r.Form.GetFormItem(0).(*tview.InputField).GetText()
 	recordTypeIndex, _ := r.Form.GetFormItem(1).(*tview.DropDown).GetCurrentOption()
 	recordTypes := []string{"A", "AAAA", "CNAME", "MX", "TXT", "NS", "SOA", "SRV", "PTR", "CAA"}
@@ -184,12 +193,12 @@ func (r *Route53RecordForm) createHandler() {
 	ttl, err := strconv.ParseInt(ttlStr, 10, 64)
 	if err != nil {
 		r.showError("Invalid TTL value")
-		return
+		return r53Types.ResourceRecordSet{}, false
 	}
 
 	if recordValue == "" {
 		r.showError("Value is required")
-		return
+		return r53Types.ResourceRecordSet{}, false
 	}
 
 	// Build full record name by appending zone name
@@ -229,17 +238,24 @@ func (r *Route53RecordForm) createHandler() {
 
 	if len(resourceRecords) == 0 {
 		r.showError("At least one value is required")
-		return
+		return r53Types.ResourceRecordSet{}, false
 	}
 
-	record := r53Types.ResourceRecordSet{
+	return r53Types.ResourceRecordSet{
 		Name:            aws.String(fullRecordName),
 		Type:            r53Types.RRType(recordType),
 		TTL:             aws.Int64(ttl),
 		ResourceRecords: resourceRecords,
+	}, true
+}
+
+func (r *Route53RecordForm) createHandler() {
+	record, ok := r.buildRecordSet()
+	if !ok {
+		return
 	}
 
-	err = r.repo.CreateRecord(r.hostedZoneId, record)
+	err := r.repo.CreateRecord(r.hostedZoneId, record)
 	if err != nil {
 		r.showError("Failed to create record: " + err.Error())
 		return
@@ -255,78 +271,46 @@ func (r *Route53RecordForm) updateHandler() {
 		return
 	}
 
-	recordName := r.Form.GetFormItem(0).(*tview.InputField).GetText()
-	recordTypeIndex, _ := r.Form.GetFormItem(1).(*tview.DropDown).GetCurrentOption()
-	recordTypes := []string{"A", "AAAA", "CNAME", "MX", "TXT", "NS", "SOA", "SRV", "PTR", "CAA"}
-	recordType := recordTypes[recordTypeIndex]
-	ttlStr := r.Form.GetFormItem(2).(*tview.InputField).GetText()
-	recordValue := r.Form.GetFormItem(3).(*tview.TextArea).GetText()
-
-	ttl, err := strconv.ParseInt(ttlStr, 10, 64)
-	if err != nil {
-		r.showError("Invalid TTL value")
+	newRecord, ok := r.buildRecordSet()
+	if !ok {
 		return
 	}
 
-	if recordValue == "" {
-		r.showError("Value is required")
+	err := r.repo.UpdateRecord(r.hostedZoneId, *r.existingRecord, newRecord)
+	if err != nil {
+		r.showError("Failed to update record: " + err.Error())
 		return
 	}
 
-	// Build full record name by appending zone name
-	zoneName := strings.TrimSuffix(r.hostedZoneName, ".")
-	var fullRecordName string
+	r.onComplete()
+	r.app.Close()
+}
 
-	recordName = strings.TrimSpace(recordName)
-	if recordName == "" || recordName == "@" {
-		// Apex record
-		fullRecordName = zoneName + "."
-	} else {
-		// Subdomain or full name
-		if strings.Contains(recordName, ".") && strings.HasSuffix(recordName, zoneName) {
-			// Already contains zone name
-			fullRecordName = recordName
-		} else {
-			// Prefix only, append zone name
-			fullRecordName = recordName + "." + zoneName
-		}
-		// Ensure it ends with a dot
-		if !strings.HasSuffix(fullRecordName, ".") {
-			fullRecordName = fullRecordName + "."
+// stageHandler queues the same changes the form would submit, so they can be reviewed and submitted together
+func (r *Route53RecordForm) stageHandler() {
+	var changes []r53Types.Change
+	if r.mode == "update" || r.mode == "delete" {
+		if r.existingRecord == nil {
+			r.showError("No existing record to change")
+			return
 		}
+		changes = append(changes, r53Types.Change{
+			Action:            r53Types.ChangeActionDelete,
+			ResourceRecordSet: r.existingRecord,
+		})
 	}
-
-	// Parse values (one per line)
-	valueLines := strings.Split(recordValue, "\n")
-	var resourceRecords []r53Types.ResourceRecord
-	for _, line := range valueLines {
-		line = strings.TrimSpace(line)
-		if line != "" {
-			resourceRecords = append(resourceRecords, r53Types.ResourceRecord{
-				Value: aws.String(line),
-			})
+	if r.mode != "delete" {
+		record, ok := r.buildRecordSet()
+		if !ok {
+			return
 		}
+		changes = append(changes, r53Types.Change{
+			Action:            r53Types.ChangeActionCreate,
+			ResourceRecordSet: &record,
+		})
 	}
 
-	if len(resourceRecords) == 0 {
-		r.showError("At least one value is required")
-		return
-	}
-
-	newRecord := r53Types.ResourceRecordSet{
-		Name:            aws.String(fullRecordName),
-		Type:            r53Types.RRType(recordType),
-		TTL:             aws.Int64(ttl),
-		ResourceRecords: resourceRecords,
-	}
-
-	err = r.repo.UpdateRecord(r.hostedZoneId, *r.existingRecord, newRecord)
-	if err != nil {
-		r.showError("Failed to update record: " + err.Error())
-		return
-	}
-
-	r.onComplete()
+	r.onStage(changes)
 	r.app.Close()
 }
 
@@ -1,12 +1,15 @@
 package internal
 
 import (
+	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
 	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/bporter816/aws-tui/internal/model"
 	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
 	"github.com/bporter816/aws-tui/internal/view"
@@ -30,9 +33,11 @@ type Route53Records struct {
 	filteredData   [][]string
 	lastFilter     string
 	isFiltered     bool
+	settings       *settings.Settings
+	staged         [][]r53Types.Change // each record edit is staged as the group of changes it makes
 }
 
-func NewRoute53Records(repo *repo.Route53, zoneId, zoneName string, app *Application) *Route53Records {
+func NewRoute53Records(repo *repo.Route53, zoneId, zoneName string, settings *settings.Settings, app *Application) *Route53Records {
 	table := ui.NewTable([]string{
 		"RECORD NAME",
 		"TYPE",
@@ -70,6 +75,7 @@ func NewRoute53Records(repo *repo.Route53, zoneId, zoneName string, app *Applica
 		filteredData:   make([][]string, 0),
 		lastFilter:     "",
 		isFiltered:     false,
+		settings:       settings,
 	}
 
 	r.setupSearch()
@@ -77,13 +83,34 @@ func NewRoute53Records(repo *repo.Route53, zoneId, zoneName string, app *Applica
 }
 
 func (r *Route53Records) GetLabels() []string {
+	if len(r.staged) > 0 {
+		return []string{r.hostedZoneId, "Records", fmt.Sprintf("%v Staged", len(r.staged))}
+	}
 	return []string{r.hostedZoneId, "Records"}
 }
 
+func (r *Route53Records) stageHandler(changes []r53Types.Change) {
+	r.staged = append(r.staged, changes)
+	r.app.footer.Render()
+}
+
+// CloseWarning keeps staged edits from being dropped silently, since they only live on this view
+func (r *Route53Records) CloseWarning() string {
+	if len(r.staged) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Discard %v staged edits to %v?", len(r.staged), strings.TrimSuffix(r.hostedZoneName, "."))
+}
+
+func (r *Route53Records) stagedChangesHandler() {
+	stagedView := NewRoute53StagedChanges(r.repo, r, r.app)
+	r.app.AddAndSwitch(stagedView)
+}
+
 func (r *Route53Records) createRecordHandler() {
 	form := NewRoute53RecordForm(r.repo, r.hostedZoneId, r.hostedZoneName, "create", nil, r.app, func() {
 		r.Render()
-	})
+	}, r.stageHandler)
 	r.app.AddAndSwitch(form)
 }
 
@@ -101,7 +128,7 @@ func (r *Route53Records) updateRecordHandler() {
 	recordSet := r53Types.ResourceRecordSet(record)
 	form := NewRoute53RecordForm(r.repo, r.hostedZoneId, r.hostedZoneName, "update", &recordSet, r.app, func() {
 		r.Render()
-	})
+	}, r.stageHandler)
 	r.app.AddAndSwitch(form)
 }
 
@@ -119,10 +146,52 @@ func (r *Route53Records) deleteRecordHandler() {
 	recordSet := r53Types.ResourceRecordSet(record)
 	form := NewRoute53RecordForm(r.repo, r.hostedZoneId, r.hostedZoneName, "delete", &recordSet, r.app, func() {
 		r.Render()
-	})
+	}, r.stageHandler)
 	r.app.AddAndSwitch(form)
 }
 
+func (r *Route53Records) exportHandler() {
+	exportForm := NewRoute53ZoneExportForm(r.repo, r.hostedZoneId, r.hostedZoneName, r.settings, r.app)
+	r.app.AddAndSwitch(exportForm)
+}
+
+func (r *Route53Records) importHandler() {
+	fileSelector := ui.NewFileSelector(r.settings.GetLocalDirectory(), func(filePath string) {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			r.app.ShowError(r.GetService(), fmt.Sprintf("Read failed: %v", err))
+			return
+		}
+		desired, err := utils.ParseRoute53ZoneFile(r.hostedZoneName, string(content))
+		if err != nil {
+			r.app.ShowError(r.GetService(), fmt.Sprintf("Invalid zone file: %v", err))
+			return
+		}
+		importView := NewRoute53ZoneImport(r.repo, r.hostedZoneId, r.hostedZoneName, filePath, desired, r.app, func() {
+			r.Render()
+		})
+		r.app.AddAndSwitch(importView)
+	})
+
+	r.app.AddAndSwitch(&ComponentWrapper{
+		Primitive: fileSelector,
+		service:   r.GetService(),
+		labels:    []string{r.hostedZoneId, "Select Zone File"},
+	})
+}
+
+func (r *Route53Records) testDNSHandler() {
+	var recordName, recordType string
+	if name, err := r.table.GetColSelection("RECORD NAME"); err == nil {
+		recordName = utils.UnescapeRoute53Name(name)
+		recordType, _ = r.table.GetColSelection("TYPE")
+	} else {
+		recordName = strings.TrimSuffix(r.hostedZoneName, ".")
+	}
+	testForm := NewRoute53DNSTestForm(r.repo, r.hostedZoneId, recordName, recordType, r.settings, r.app)
+	r.app.AddAndSwitch(testForm)
+}
+
 func (r *Route53Records) setupSearch() {
 	r.searchField.SetChangedFunc(func(text string) {
 		r.filterDataLive(text)
@@ -259,6 +328,26 @@ func (r *Route53Records) GetKeyActions() []KeyAction {
 			Description: "Create",
 			Action:      r.createRecordHandler,
 		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone),
+			Description: "Staged Changes",
+			Action:      r.stagedChangesHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 't', tcell.ModNone),
+			Description: "Test DNS",
+			Action:      r.testDNSHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone),
+			Description: "Export",
+			Action:      r.exportHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'i', tcell.ModNone),
+			Description: "Import",
+			Action:      r.importHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone),
 			Description: "Update",
@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+// Route53StagedChanges shows the record edits queued in a hosted zone as a diff, and submits them as one change batch
+type Route53StagedChanges struct {
+	*ui.Text
+	view.Route53
+	repo    *repo.Route53
+	records *Route53Records
+	app     *Application
+}
+
+func NewRoute53StagedChanges(repo *repo.Route53, records *Route53Records, app *Application) *Route53StagedChanges {
+	r := &Route53StagedChanges{
+		Text:    ui.NewText(true, "diff"),
+		repo:    repo,
+		records: records,
+		app:     app,
+	}
+	return r
+}
+
+func (r Route53StagedChanges) GetLabels() []string {
+	return []string{r.records.hostedZoneId, "Staged Changes"}
+}
+
+// getChanges flattens the staged edits into one batch, collapsing repeated edits of the same record
+func (r Route53StagedChanges) getChanges() []r53Types.Change {
+	var changes []r53Types.Change
+	for _, v := range r.records.staged {
+		changes = append(changes, v...)
+	}
+	return utils.CollapseRoute53Changes(changes)
+}
+
+func (r *Route53StagedChanges) submitHandler() {
+	changes := r.getChanges()
+	if len(changes) == 0 {
+		r.app.ShowMessage(r.GetService(), "There are no staged changes")
+		return
+	}
+	msg := fmt.Sprintf(
+		"Submit %v changes from %v staged edits to %v as a single change batch?",
+		len(changes),
+		len(r.records.staged),
+		strings.TrimSuffix(r.records.hostedZoneName, "."),
+	)
+	r.app.Confirm(r.GetService(), msg, "Submit", func() {
+		changeInfo, err := r.repo.ChangeRecords(r.records.hostedZoneId, changes, "")
+		if err != nil {
+			r.app.ShowError(r.GetService(), fmt.Sprintf("Submit failed: %v", err))
+			return
+		}
+		r.records.staged = nil
+		r.app.Close()
+		r.records.Render()
+		trackRoute53Change(r.repo, utils.DerefString(changeInfo.Id, ""), r.app)
+	})
+}
+
+func (r *Route53StagedChanges) undoHandler() {
+	if len(r.records.staged) == 0 {
+		return
+	}
+	r.records.staged = r.records.staged[:len(r.records.staged)-1]
+	r.Render()
+}
+
+func (r *Route53StagedChanges) clearHandler() {
+	if len(r.records.staged) == 0 {
+		return
+	}
+	r.app.Confirm(r.GetService(), fmt.Sprintf("Discard %v staged edits?", len(r.records.staged)), "Discard", func() {
+		r.records.staged = nil
+		r.Render()
+	})
+}
+
+func (r *Route53StagedChanges) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone),
+			Description: "Submit",
+			Action:      r.submitHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'u', tcell.ModNone),
+			Description: "Undo Last Edit",
+			Action:      r.undoHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'C', tcell.ModNone),
+			Description: "Discard All",
+			Action:      r.clearHandler,
+		},
+	}
+}
+
+func (r *Route53StagedChanges) Render() {
+	if len(r.records.staged) == 0 {
+		r.SetText("No staged changes. Use Stage in the record form to queue an edit.")
+		return
+	}
+	// show the batch that will be submitted, after repeated edits of a record are collapsed
+	r.SetText(utils.FormatRoute53Changes(r.getChanges()))
+}
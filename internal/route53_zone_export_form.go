@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/settings"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type Route53ZoneExportForm struct {
+	*tview.Form
+	view.Route53
+	repo           *repo.Route53
+	hostedZoneId   string
+	hostedZoneName string
+	settings       *settings.Settings
+	app            *Application
+}
+
+func NewRoute53ZoneExportForm(repo *repo.Route53, hostedZoneId, hostedZoneName string, settings *settings.Settings, app *Application) *Route53ZoneExportForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Export Zone ")
+	form.SetTitleColor(tcell.ColorBlue)
+
+	r := &Route53ZoneExportForm{
+		Form:           form,
+		repo:           repo,
+		hostedZoneId:   hostedZoneId,
+		hostedZoneName: hostedZoneName,
+		settings:       settings,
+		app:            app,
+	}
+
+	form.AddInputField("Hosted Zone", strings.TrimSuffix(hostedZoneName, "."), 0, nil, nil).
+		AddInputField("Local Directory", settings.GetLocalDirectory(), 0, nil, nil).
+		AddInputField("Filename", strings.TrimSuffix(hostedZoneName, ".")+".zone", 0, nil, nil)
+
+	form.AddButton("Export", r.exportHandler)
+	form.AddButton("Cancel", r.cancelHandler)
+
+	form.GetFormItem(0).(*tview.InputField).SetDisabled(true)
+	form.GetFormItem(1).(*tview.InputField).SetDisabled(true)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorBlue)
+	form.SetButtonTextColor(tcell.ColorWhite)
+
+	return r
+}
+
+func (r *Route53ZoneExportForm) exportHandler() {
+	dirPath := r.GetFormItem(1).(*tview.InputField).GetText()
+	filename := strings.TrimSpace(r.GetFormItem(2).(*tview.InputField).GetText())
+	if filename == "" {
+		r.app.ShowError(r.GetService(), "Filename is required")
+		return
+	}
+	destPath := filepath.Join(dirPath, filename)
+
+	records, err := r.repo.ListRecords(r.hostedZoneId)
+	if err != nil {
+		r.app.ShowError(r.GetService(), fmt.Sprintf("List records failed: %v", err))
+		return
+	}
+	var recordSets []r53Types.ResourceRecordSet
+	for _, v := range records {
+		recordSets = append(recordSets, r53Types.ResourceRecordSet(v))
+	}
+
+	content := utils.FormatRoute53ZoneFile(r.hostedZoneName, recordSets)
+	if err := os.WriteFile(destPath, []byte(content), 0644); err != nil {
+		r.app.ShowError(r.GetService(), fmt.Sprintf("Export failed: %v", err))
+		return
+	}
+
+	r.app.Close()
+	r.app.ShowMessage(r.GetService(), fmt.Sprintf("Exported %v record sets to %v", len(recordSets), destPath))
+}
+
+func (r *Route53ZoneExportForm) cancelHandler() {
+	r.app.Close()
+}
+
+func (r Route53ZoneExportForm) GetLabels() []string {
+	return []string{r.hostedZoneId, "Export"}
+}
+
+func (r Route53ZoneExportForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (r Route53ZoneExportForm) Render() {
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+// Route53ZoneImport reviews the changes needed to make a hosted zone match a zone file before applying them
+type Route53ZoneImport struct {
+	*ui.Table
+	view.Route53
+	repo           *repo.Route53
+	hostedZoneId   string
+	hostedZoneName string
+	filePath       string
+	desired        []r53Types.ResourceRecordSet
+	includeDeletes bool
+	changes        []r53Types.Change
+	app            *Application
+	onComplete     func()
+}
+
+func NewRoute53ZoneImport(repo *repo.Route53, hostedZoneId, hostedZoneName, filePath string, desired []r53Types.ResourceRecordSet, app *Application, onComplete func()) *Route53ZoneImport {
+	r := &Route53ZoneImport{
+		Table: ui.NewTable([]string{
+			"ACTION",
+			"RECORD NAME",
+			"TYPE",
+			"TTL",
+			"VALUE",
+		}, 1, 0),
+		repo:           repo,
+		hostedZoneId:   hostedZoneId,
+		hostedZoneName: hostedZoneName,
+		filePath:       filePath,
+		desired:        desired,
+		includeDeletes: true,
+		app:            app,
+		onComplete:     onComplete,
+	}
+	return r
+}
+
+func (r Route53ZoneImport) GetLabels() []string {
+	labels := []string{r.hostedZoneId, "Import", filepath.Base(r.filePath)}
+	if !r.includeDeletes {
+		labels = append(labels, "Without Deletes")
+	}
+	return labels
+}
+
+func (r *Route53ZoneImport) toggleDeletesHandler() {
+	r.includeDeletes = !r.includeDeletes
+	r.Render()
+}
+
+func (r *Route53ZoneImport) applyHandler() {
+	if len(r.changes) == 0 {
+		r.app.ShowMessage(r.GetService(), "The hosted zone already matches "+filepath.Base(r.filePath))
+		return
+	}
+	counts := make(map[r53Types.ChangeAction]int)
+	for _, v := range r.changes {
+		counts[v.Action]++
+	}
+	msg := fmt.Sprintf(
+		"Apply %v creates, %v updates and %v deletes to %v in a single change batch?",
+		counts[r53Types.ChangeActionCreate],
+		counts[r53Types.ChangeActionUpsert],
+		counts[r53Types.ChangeActionDelete],
+		strings.TrimSuffix(r.hostedZoneName, "."),
+	)
+	r.app.Confirm(r.GetService(), msg, "Apply", func() {
+		changeInfo, err := r.repo.ChangeRecords(r.hostedZoneId, r.changes, "Imported from "+filepath.Base(r.filePath))
+		if err != nil {
+			r.app.ShowError(r.GetService(), fmt.Sprintf("Import failed: %v", err))
+			return
+		}
+		// close the review and the file selector underneath it
+		r.app.Close()
+		r.app.Close()
+		if r.onComplete != nil {
+			r.onComplete()
+		}
+		trackRoute53Change(r.repo, utils.DerefString(changeInfo.Id, ""), r.app)
+	})
+}
+
+func (r *Route53ZoneImport) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone),
+			Description: "Apply",
+			Action:      r.applyHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'D', tcell.ModNone),
+			Description: "Toggle Deletes",
+			Action:      r.toggleDeletesHandler,
+		},
+	}
+}
+
+func (r *Route53ZoneImport) Render() {
+	model, err := r.repo.ListRecords(r.hostedZoneId)
+	if err != nil {
+		panic(err)
+	}
+	var existing []r53Types.ResourceRecordSet
+	for _, v := range model {
+		existing = append(existing, r53Types.ResourceRecordSet(v))
+	}
+	r.changes = utils.ComputeRoute53ZoneChanges(r.hostedZoneName, existing, r.desired, r.includeDeletes)
+
+	var data [][]string
+	for _, v := range r.changes {
+		var name, ttl string
+		if v.ResourceRecordSet.Name != nil {
+			name = strings.TrimSuffix(utils.UnescapeRoute53Name(*v.ResourceRecordSet.Name), ".")
+		}
+		if v.ResourceRecordSet.TTL != nil {
+			ttl = strconv.FormatInt(*v.ResourceRecordSet.TTL, 10)
+		}
+		action := utils.TitleCase(string(v.Action))
+		if v.Action == r53Types.ChangeActionUpsert {
+			action = "Update"
+		}
+		data = append(data, []string{
+			action,
+			name,
+			string(v.ResourceRecordSet.Type),
+			ttl,
+			utils.JoinRoute53ResourceRecords(v.ResourceRecordSet.ResourceRecords, ", "),
+		})
+	}
+	r.SetData(data)
+}
@@ -105,6 +105,8 @@ func NewServices(repos map[string]interface{}, app *Application) *Services {
 		},
 		"RDS": {
 			"Clusters",
+			"Instances",
+			"Snapshots",
 			"Global Clusters",
 			"Parameter Groups",
 			"Subnet Groups",
@@ -131,6 +133,7 @@ func NewServices(repos map[string]interface{}, app *Application) *Services {
 		},
 		"Systems Manager": {
 			"Parameters",
+			"Parameter Hierarchy",
 		},
 		"VPC": {
 			"VPCs",
@@ -139,11 +142,8 @@ func NewServices(repos map[string]interface{}, app *Application) *Services {
 		},
 	}
 
-	// Load settings
-	userSettings, err := settings.Load()
-	if err != nil {
-		userSettings = &settings.Settings{Favorites: []string{}}
-	}
+	// settings are loaded once by the application and shared with every view
+	userSettings := app.settings
 
 	root := tview.NewTreeNode("")
 	s := &Services{
@@ -250,11 +250,11 @@ func (s Services) selectHandler(n *tview.TreeNode) {
 	var item Component
 	switch view {
 	case "ACM.Certificates":
-		item = NewACMCertificates(s.repos["ACM"].(*repo.ACM), s.app)
+		item = NewACMCertificates(s.repos["ACM"].(*repo.ACM), s.repos["Route 53"].(*repo.Route53), s.settings, s.app)
 	case "ACM PCA.Certificate Authorities":
 		item = NewACMPCACertificateAuthorities(s.repos["ACM PCA"].(*repo.ACMPCA), s.app)
 	case "CloudFront.Distributions":
-		item = NewCFDistributions(s.repos["CloudFront"].(*repo.CloudFront), s.app)
+		item = NewCFDistributions(s.repos["CloudFront"].(*repo.CloudFront), s.settings, s.app)
 	case "CloudFront.Functions":
 		item = NewCFFunctions(s.repos["CloudFront"].(*repo.CloudFront), s.app)
 	case "CloudWatch.Log Groups":
@@ -276,7 +276,7 @@ func (s Services) selectHandler(n *tview.TreeNode) {
 	case "EC2.Reserved Instances":
 		item = NewEC2ReservedInstances(s.repos["EC2"].(*repo.EC2), s.app)
 	case "ECS.Clusters":
-		item = NewECSClusters(s.repos["ECS"].(*repo.ECS), s.app)
+		item = NewECSClusters(s.repos["ECS"].(*repo.ECS), s.repos["CloudWatch"].(*repo.CloudWatch), s.app)
 	case "ECS.Task Definitions":
 		item = NewECSTaskDefinitions(s.repos["ECS"].(*repo.ECS), s.app)
 	case "EKS.Clusters":
@@ -302,7 +302,7 @@ func (s Services) selectHandler(n *tview.TreeNode) {
 	case "ElastiCache.Snapshots":
 		item = NewElastiCacheSnapshots(s.repos["ElastiCache"].(*repo.ElastiCache), s.app)
 	case "ElastiCache.Events":
-		item = NewElastiCacheEvents(s.repos["ElastiCache"].(*repo.ElastiCache), s.app)
+		item = NewElastiCacheEvents(s.repos["ElastiCache"].(*repo.ElastiCache), nil, "", s.app)
 	case "ElastiCache.Service Updates":
 		item = NewElastiCacheServiceUpdates(s.repos["ElastiCache"].(*repo.ElastiCache), s.app)
 	case "Global Accelerator.Accelerators":
@@ -320,13 +320,17 @@ func (s Services) selectHandler(n *tview.TreeNode) {
 	case "KMS.Custom Key Stores":
 		item = NewKmsCustomKeyStores(s.repos["KMS"].(*repo.KMS), s.app)
 	case "Lambda.Functions":
-		item = NewLambdaFunctions(s.repos["Lambda"].(*repo.Lambda), s.app)
+		item = NewLambdaFunctions(s.repos["Lambda"].(*repo.Lambda), s.settings, s.app)
 	case "MQ.Brokers":
 		item = NewMQBrokers(s.repos["MQ"].(*repo.MQ), s.app)
 	case "MSK.Clusters":
 		item = NewMSKClusters(s.repos["MSK"].(*repo.MSK), s.app)
 	case "RDS.Clusters":
 		item = NewRDSClusters(s.repos["RDS"].(*repo.RDS), s.app)
+	case "RDS.Instances":
+		item = NewRDSInstances(s.repos["RDS"].(*repo.RDS), s.app, "")
+	case "RDS.Snapshots":
+		item = NewRDSSnapshots(s.repos["RDS"].(*repo.RDS), s.app)
 	case "RDS.Global Clusters":
 		item = NewRDSGlobalClusters(s.repos["RDS"].(*repo.RDS), s.app)
 	case "RDS.Parameter Groups":
@@ -336,7 +340,7 @@ func (s Services) selectHandler(n *tview.TreeNode) {
 	case "RDS.Reserved Instances":
 		item = NewRDSReservedInstances(s.repos["RDS"].(*repo.RDS), s.app)
 	case "Route 53.Hosted Zones":
-		item = NewRoute53HostedZones(s.repos["Route 53"].(*repo.Route53), s.app)
+		item = NewRoute53HostedZones(s.repos["Route 53"].(*repo.Route53), s.settings, s.app)
 	case "Route 53.Health Checks":
 		item = NewRoute53HealthChecks(s.repos["Route 53"].(*repo.Route53), s.app)
 	case "S3.Buckets":
@@ -351,6 +355,8 @@ func (s Services) selectHandler(n *tview.TreeNode) {
 		item = NewServiceQuotasServices(s.repos["Service Quotas"].(*repo.ServiceQuotas), s.app)
 	case "Systems Manager.Parameters":
 		item = NewSSMParameters(s.repos["SSM"].(*repo.SSM), s.app)
+	case "Systems Manager.Parameter Hierarchy":
+		item = NewSSMParameterTree(s.repos["SSM"].(*repo.SSM), s.repos["KMS"].(*repo.KMS), s.app)
 	case "VPC.VPCs":
 		item = NewVPCVPCs(s.repos["EC2"].(*repo.EC2), s.app)
 	case "VPC.Subnets":
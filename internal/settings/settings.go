@@ -7,10 +7,27 @@ import (
 )
 
 type Settings struct {
-	Favorites      []string `json:"favorites"`
-	LocalDirectory string   `json:"local_directory,omitempty"`
+	Favorites      []string                     `json:"favorites"`
+	LocalDirectory string                       `json:"local_directory,omitempty"`
+	LambdaPayloads map[string]map[string]string `json:"lambda_payloads,omitempty"`
+	// ClipboardClearSeconds clears values copied to the clipboard after this many seconds, if set
+	ClipboardClearSeconds int `json:"clipboard_clear_seconds,omitempty"`
+	// InvalidationPaths holds the most recently invalidated paths for each CloudFront distribution, newest first
+	InvalidationPaths map[string][]string `json:"invalidation_paths,omitempty"`
+	// DNSNameserver is the nameserver used for local DNS lookups instead of the system resolver, if set
+	DNSNameserver string `json:"dns_nameserver,omitempty"`
+	// ACMExpiryWarningDays and ACMExpiryCriticalDays highlight certificates that expire within that many days
+	ACMExpiryWarningDays  int `json:"acm_expiry_warning_days,omitempty"`
+	ACMExpiryCriticalDays int `json:"acm_expiry_critical_days,omitempty"`
 }
 
+const maxRecentInvalidationPaths = 20
+
+const (
+	defaultACMExpiryWarningDays  = 30
+	defaultACMExpiryCriticalDays = 7
+)
+
 func getSettingsPath() (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -122,3 +139,92 @@ func (s *Settings) SetLocalDirectory(dir string) error {
 	s.LocalDirectory = dir
 	return s.Save()
 }
+
+// GetLambdaPayloads returns the saved test payloads for a function, keyed by payload name
+func (s *Settings) GetLambdaPayloads(functionName string) map[string]string {
+	if s.LambdaPayloads == nil {
+		return map[string]string{}
+	}
+	if payloads, ok := s.LambdaPayloads[functionName]; ok {
+		return payloads
+	}
+	return map[string]string{}
+}
+
+func (s *Settings) SaveLambdaPayload(functionName, name, payload string) error {
+	if s.LambdaPayloads == nil {
+		s.LambdaPayloads = map[string]map[string]string{}
+	}
+	if _, ok := s.LambdaPayloads[functionName]; !ok {
+		s.LambdaPayloads[functionName] = map[string]string{}
+	}
+	s.LambdaPayloads[functionName][name] = payload
+	return s.Save()
+}
+
+func (s *Settings) DeleteLambdaPayload(functionName, name string) error {
+	payloads, ok := s.LambdaPayloads[functionName]
+	if !ok {
+		return nil
+	}
+	if _, ok := payloads[name]; !ok {
+		return nil
+	}
+	delete(payloads, name)
+	if len(payloads) == 0 {
+		delete(s.LambdaPayloads, functionName)
+	}
+	return s.Save()
+}
+
+func (s *Settings) GetRecentInvalidationPaths(distributionId string) []string {
+	if s.InvalidationPaths == nil {
+		return []string{}
+	}
+	return s.InvalidationPaths[distributionId]
+}
+
+// AddRecentInvalidationPaths moves the given paths to the front of the history, keeping only the most recent ones
+func (s *Settings) AddRecentInvalidationPaths(distributionId string, paths []string) error {
+	if s.InvalidationPaths == nil {
+		s.InvalidationPaths = map[string][]string{}
+	}
+	recent := append([]string{}, paths...)
+	for _, v := range s.InvalidationPaths[distributionId] {
+		found := false
+		for _, p := range paths {
+			if p == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			recent = append(recent, v)
+		}
+	}
+	if len(recent) > maxRecentInvalidationPaths {
+		recent = recent[:maxRecentInvalidationPaths]
+	}
+	s.InvalidationPaths[distributionId] = recent
+	return s.Save()
+}
+
+func (s *Settings) SetDNSNameserver(nameserver string) error {
+	if s.DNSNameserver == nameserver {
+		return nil
+	}
+	s.DNSNameserver = nameserver
+	return s.Save()
+}
+
+// GetACMExpiryThresholds returns the warning and critical certificate expiry thresholds in days
+func (s *Settings) GetACMExpiryThresholds() (int, int) {
+	warning, critical := s.ACMExpiryWarningDays, s.ACMExpiryCriticalDays
+	if warning <= 0 {
+		warning = defaultACMExpiryWarningDays
+	}
+	if critical <= 0 {
+		critical = defaultACMExpiryCriticalDays
+	}
+	return warning, critical
+}
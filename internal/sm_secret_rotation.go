@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type SMSecretRotation struct {
+	*ui.Table
+	view.SecretsManager
+	repo       *repo.SecretsManager
+	secretName string
+	app        *Application
+}
+
+func NewSMSecretRotation(repo *repo.SecretsManager, secretName string, app *Application) *SMSecretRotation {
+	s := &SMSecretRotation{
+		Table: ui.NewTable([]string{
+			"KEY",
+			"VALUE",
+		}, 1, 0),
+		repo:       repo,
+		secretName: secretName,
+		app:        app,
+	}
+	return s
+}
+
+func (s SMSecretRotation) GetLabels() []string {
+	return []string{s.secretName, "Rotation"}
+}
+
+func (s *SMSecretRotation) rotateHandler() {
+	s.app.Confirm(s.GetService(), "Rotate "+s.secretName+" now?", "Rotate", func() {
+		if err := s.repo.RotateSecret(s.secretName); err != nil {
+			s.app.ShowError(s.GetService(), fmt.Sprintf("Rotate secret failed: %v", err))
+			return
+		}
+		s.Render()
+	})
+}
+
+func (s *SMSecretRotation) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'R', tcell.ModNone),
+			Description: "Rotate Now",
+			Action:      s.rotateHandler,
+		},
+	}
+}
+
+func (s SMSecretRotation) Render() {
+	model, err := s.repo.DescribeSecret(s.secretName)
+	if err != nil {
+		panic(err)
+	}
+
+	rotationEnabled := "No"
+	if model.RotationEnabled != nil {
+		rotationEnabled = utils.BoolToString(*model.RotationEnabled, "Yes", "No")
+	}
+	var schedule, window string
+	if r := model.RotationRules; r != nil {
+		if r.ScheduleExpression != nil {
+			schedule = *r.ScheduleExpression
+		} else if r.AutomaticallyAfterDays != nil {
+			schedule = "Every " + strconv.FormatInt(*r.AutomaticallyAfterDays, 10) + " days"
+		}
+		window = utils.DerefString(r.Duration, "")
+	}
+	var lastRotated, nextRotation, lastChanged string
+	if model.LastRotatedDate != nil {
+		lastRotated = model.LastRotatedDate.Format(utils.DefaultTimeFormat)
+	}
+	if model.NextRotationDate != nil {
+		nextRotation = model.NextRotationDate.Format(utils.DefaultTimeFormat)
+	}
+	if model.LastChangedDate != nil {
+		lastChanged = model.LastChangedDate.Format(utils.DefaultTimeFormat)
+	}
+	// the version being rotated in, if a rotation is in progress
+	pending := "-"
+	for id, stages := range model.VersionIdsToStages {
+		for _, stage := range stages {
+			if stage == "AWSPENDING" {
+				pending = id
+			}
+		}
+	}
+
+	data := [][]string{
+		{"Rotation Enabled", rotationEnabled},
+		{"Rotation Function", utils.DerefString(model.RotationLambdaARN, "-")},
+		{"Schedule", schedule},
+		{"Window Duration", window},
+		{"Last Rotated", lastRotated},
+		{"Next Rotation", nextRotation},
+		{"Last Changed", lastChanged},
+		{"Pending Version", pending},
+	}
+	s.SetData(data)
+}
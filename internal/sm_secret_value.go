@@ -2,64 +2,159 @@ package internal
 
 import (
 	"encoding/json"
+	"sort"
+
 	"github.com/bporter816/aws-tui/internal/repo"
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
 type SMSecretValue struct {
-	tview.Primitive
+	*tview.Pages
 	view.SecretsManager
 	secretName string
+	versionId  string
 	valueKV    map[string]string
 	valueText  string
+	loadErr    error
+	masked     *maskedValues
+	table      *ui.Table
+	text       *ui.Text
 	repo       *repo.SecretsManager
 	app        *Application
 }
 
-func NewSMSecretValue(repo *repo.SecretsManager, secretName string, app *Application) *SMSecretValue {
+// NewSMSecretValue shows a version of a secret, or the AWSCURRENT version if versionId is empty
+func NewSMSecretValue(repo *repo.SecretsManager, secretName string, versionId string, app *Application) *SMSecretValue {
 	s := &SMSecretValue{
+		Pages:      tview.NewPages(),
 		secretName: secretName,
+		versionId:  versionId,
+		table:      ui.NewTable([]string{"KEY", "VALUE"}, 1, 0),
+		text:       ui.NewText(false, ""),
+		masked:     newMaskedValues(),
 		repo:       repo,
 		app:        app,
 	}
+	s.AddPage("table", s.table, true, false)
+	s.AddPage("text", s.text, true, false)
+	return s
+}
 
-	secretValue, err := s.repo.GetSecretValue(s.secretName)
+func (s SMSecretValue) GetLabels() []string {
+	if s.versionId != "" {
+		return []string{s.secretName, s.versionId, "Value"}
+	}
+	return []string{s.secretName, "Value"}
+}
+
+func (s *SMSecretValue) editHandler() {
+	if s.loadErr != nil {
+		s.app.ShowError(s.GetService(), "The secret value could not be loaded")
+		return
+	}
+	editForm := NewSMSecretValueForm(s.repo, s.secretName, s.valueKV, s.valueText, s.app, func() {
+		// show the new version, which is now AWSCURRENT
+		s.versionId = ""
+		s.Render()
+	})
+	s.app.AddAndSwitch(editForm)
+}
+
+// getSelectedKey returns the key of the selected row, or an empty key for plaintext secrets
+func (s *SMSecretValue) getSelectedKey() (string, bool) {
+	if s.loadErr != nil {
+		return "", false
+	}
+	if s.valueKV == nil {
+		return "", true
+	}
+	key, err := s.table.GetColSelection("KEY")
 	if err != nil {
-		panic(err)
+		return "", false
 	}
+	return key, true
+}
 
-	var kv map[string]string
-	err = json.Unmarshal([]byte(secretValue), &kv)
-	if err == nil {
-		s.Primitive = ui.NewTable([]string{"KEY", "VALUE"}, 1, 0)
-		s.valueKV = kv
-	} else {
-		s.Primitive = ui.NewText(false, "")
-		s.valueText = secretValue
+func (s *SMSecretValue) revealHandler() {
+	key, ok := s.getSelectedKey()
+	if !ok {
+		return
 	}
-	return s
+	s.masked.Toggle(key)
+	s.setData()
 }
 
-func (s SMSecretValue) GetLabels() []string {
-	return []string{s.secretName, "Value"}
+func (s *SMSecretValue) copyHandler() {
+	key, ok := s.getSelectedKey()
+	if !ok {
+		return
+	}
+	if s.valueKV == nil {
+		s.app.CopyToClipboard(s.GetService(), s.valueText)
+	} else {
+		s.app.CopyToClipboard(s.GetService(), s.valueKV[key])
+	}
 }
 
-func (s SMSecretValue) GetKeyActions() []KeyAction {
-	return []KeyAction{}
+func (s *SMSecretValue) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone),
+			Description: "Reveal/Hide Value",
+			Action:      s.revealHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'y', tcell.ModNone),
+			Description: "Copy Value",
+			Action:      s.copyHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'e', tcell.ModNone),
+			Description: "Edit (New Version)",
+			Action:      s.editHandler,
+		},
+	}
 }
 
-func (s SMSecretValue) Render() {
-	if v, ok := s.Primitive.(*ui.Table); ok {
-		var data [][]string
-		for k, v := range s.valueKV {
-			data = append(data, []string{k, v})
-		}
-		v.SetData(data)
-	} else if v, ok := s.Primitive.(*ui.Text); ok {
-		v.SetText(s.valueText)
+func (s *SMSecretValue) Render() {
+	secretValue, err := s.repo.GetSecretValue(s.secretName, s.versionId)
+	s.loadErr = err
+	if err != nil {
+		s.valueKV, s.valueText = nil, ""
+		s.text.SetText("Failed to get secret value: " + err.Error())
+		s.SwitchToPage("text")
+		return
+	}
+
+	var kv map[string]string
+	if err := json.Unmarshal([]byte(secretValue), &kv); err == nil {
+		s.valueKV, s.valueText = kv, ""
 	} else {
-		panic("invalid secret value type")
+		s.valueKV, s.valueText = nil, secretValue
+	}
+	s.setData()
+}
+
+// setData shows the current value, with values masked until they are revealed
+func (s *SMSecretValue) setData() {
+	if s.valueKV == nil {
+		s.text.SetText(s.masked.Format("", s.valueText))
+		s.SwitchToPage("text")
+		return
+	}
+
+	var keys []string
+	for k := range s.valueKV {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var data [][]string
+	for _, k := range keys {
+		data = append(data, []string{k, s.masked.Format(k, s.valueKV[k])})
 	}
+	s.table.SetData(data)
+	s.SwitchToPage("table")
 }
@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type SMSecretValueForm struct {
+	*tview.Form
+	view.SecretsManager
+	repo       *repo.SecretsManager
+	secretName string
+	isKV       bool
+	keys       []string
+	app        *Application
+	onComplete func()
+}
+
+// NewSMSecretValueForm edits a key/value secret one masked field per key, or a plaintext secret as a whole.
+// Saving stores a new version rather than changing the existing one.
+func NewSMSecretValueForm(repo *repo.SecretsManager, secretName string, valueKV map[string]string, valueText string, app *Application, onComplete func()) *SMSecretValueForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" New Version of " + secretName + " ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	s := &SMSecretValueForm{
+		Form:       form,
+		repo:       repo,
+		secretName: secretName,
+		isKV:       valueKV != nil,
+		app:        app,
+		onComplete: onComplete,
+	}
+
+	if s.isKV {
+		for k := range valueKV {
+			s.keys = append(s.keys, k)
+		}
+		sort.Strings(s.keys)
+		for _, k := range s.keys {
+			form.AddPasswordField(k, valueKV[k], 0, '*', nil)
+		}
+		form.AddInputField("New Key", "", 0, nil, nil)
+		form.AddPasswordField("New Value", "", 0, '*', nil)
+		form.AddCheckbox("Remove Empty Keys", true, nil)
+	} else {
+		form.AddTextArea("Value", valueText, 0, 12, 0, nil)
+	}
+
+	form.AddButton("Save", s.saveHandler)
+	form.AddButton("Cancel", s.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return s
+}
+
+func (s *SMSecretValueForm) getValue() (string, error) {
+	if !s.isKV {
+		value := s.GetFormItem(0).(*tview.TextArea).GetText()
+		if len(value) == 0 {
+			return "", fmt.Errorf("Secret value is required")
+		}
+		return value, nil
+	}
+
+	n := len(s.keys)
+	removeEmpty := s.GetFormItem(n + 2).(*tview.Checkbox).IsChecked()
+	kv := make(map[string]string)
+	for i, k := range s.keys {
+		value := s.GetFormItem(i).(*tview.InputField).GetText()
+		if len(value) == 0 && removeEmpty {
+			continue
+		}
+		kv[k] = value
+	}
+	newKey := strings.TrimSpace(s.GetFormItem(n).(*tview.InputField).GetText())
+	newValue := s.GetFormItem(n + 1).(*tview.InputField).GetText()
+	if newKey != "" {
+		if _, ok := kv[newKey]; ok {
+			return "", fmt.Errorf("Key %v already exists", newKey)
+		}
+		kv[newKey] = newValue
+	}
+	b, err := json.Marshal(kv)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (s *SMSecretValueForm) saveHandler() {
+	value, err := s.getValue()
+	if err != nil {
+		s.app.ShowError(s.GetService(), err.Error())
+		return
+	}
+	if _, err := s.repo.PutSecretValue(s.secretName, value); err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Put secret value failed: %v", err))
+		return
+	}
+
+	s.app.Close()
+	if s.onComplete != nil {
+		s.onComplete()
+	}
+}
+
+func (s *SMSecretValueForm) cancelHandler() {
+	s.app.Close()
+}
+
+func (s SMSecretValueForm) GetLabels() []string {
+	return []string{s.secretName, "New Version"}
+}
+
+func (s SMSecretValueForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (s SMSecretValueForm) Render() {
+}
@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type SMSecretVersions struct {
+	*ui.Table
+	view.SecretsManager
+	repo       *repo.SecretsManager
+	secretName string
+	app        *Application
+	model      []model.SecretsManagerSecretVersion
+}
+
+func NewSMSecretVersions(repo *repo.SecretsManager, secretName string, app *Application) *SMSecretVersions {
+	s := &SMSecretVersions{
+		Table: ui.NewTable([]string{
+			"VERSION ID",
+			"STAGING LABELS",
+			"CREATED",
+			"LAST ACCESSED",
+		}, 1, 0),
+		repo:       repo,
+		secretName: secretName,
+		app:        app,
+	}
+	return s
+}
+
+func (s SMSecretVersions) GetLabels() []string {
+	return []string{s.secretName, "Versions"}
+}
+
+func (s SMSecretVersions) valueHandler() {
+	versionId, err := s.GetColSelection("VERSION ID")
+	if err != nil {
+		return
+	}
+	valueView := NewSMSecretValue(s.repo, s.secretName, versionId, s.app)
+	s.app.AddAndSwitch(valueView)
+}
+
+func (s SMSecretVersions) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone),
+			Description: "Get Secret Value",
+			Action:      s.valueHandler,
+		},
+	}
+}
+
+func (s *SMSecretVersions) Render() {
+	model, err := s.repo.ListSecretVersions(s.secretName)
+	if err != nil {
+		panic(err)
+	}
+	// newest first
+	sort.SliceStable(model, func(i, j int) bool {
+		if model[i].CreatedDate == nil || model[j].CreatedDate == nil {
+			return model[i].CreatedDate != nil
+		}
+		return model[i].CreatedDate.After(*model[j].CreatedDate)
+	})
+	s.model = model
+
+	var data [][]string
+	for _, v := range model {
+		var created, lastAccessed string
+		if v.CreatedDate != nil {
+			created = v.CreatedDate.Format(utils.DefaultTimeFormat)
+		}
+		if v.LastAccessedDate != nil {
+			lastAccessed = v.LastAccessedDate.Format(utils.DefaultTimeFormat)
+		}
+		labels := "-"
+		if len(v.VersionStages) > 0 {
+			labels = strings.Join(v.VersionStages, ", ")
+		}
+		data = append(data, []string{
+			utils.DerefString(v.VersionId, ""),
+			labels,
+			created,
+			lastAccessed,
+		})
+	}
+	s.SetData(data)
+}
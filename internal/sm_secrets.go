@@ -47,10 +47,28 @@ func (s SMSecrets) valueHandler() {
 	if err != nil {
 		return
 	}
-	valueView := NewSMSecretValue(s.repo, secretName, s.app)
+	valueView := NewSMSecretValue(s.repo, secretName, "", s.app)
 	s.app.AddAndSwitch(valueView)
 }
 
+func (s SMSecrets) versionsHandler() {
+	secretName, err := s.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	versionsView := NewSMSecretVersions(s.repo, secretName, s.app)
+	s.app.AddAndSwitch(versionsView)
+}
+
+func (s SMSecrets) rotationHandler() {
+	secretName, err := s.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	rotationView := NewSMSecretRotation(s.repo, secretName, s.app)
+	s.app.AddAndSwitch(rotationView)
+}
+
 func (s SMSecrets) tagsHandler() {
 	secretName, err := s.GetColSelection("NAME")
 	if err != nil {
@@ -72,6 +90,16 @@ func (s SMSecrets) GetKeyActions() []KeyAction {
 			Description: "Get Secret Value",
 			Action:      s.valueHandler,
 		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'V', tcell.ModNone),
+			Description: "Versions",
+			Action:      s.versionsHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone),
+			Description: "Rotation",
+			Action:      s.rotationHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type SNSFilterPolicy struct {
+	*ui.Text
+	view.SNS
+	repo            *repo.SNS
+	subscriptionArn string
+	app             *Application
+	policy          string
+	scope           string
+}
+
+func NewSNSFilterPolicy(repo *repo.SNS, subscriptionArn string, app *Application) *SNSFilterPolicy {
+	s := &SNSFilterPolicy{
+		Text:            ui.NewText(true, "json"),
+		repo:            repo,
+		subscriptionArn: subscriptionArn,
+		app:             app,
+	}
+	return s
+}
+
+func (s SNSFilterPolicy) GetLabels() []string {
+	arn, err := arn.Parse(s.subscriptionArn)
+	if err != nil {
+		panic(err)
+	}
+	labels := []string{utils.GetResourceNameFromArn(arn), "Filter Policy"}
+	if s.scope != "" {
+		labels = append(labels, s.scope)
+	}
+	return labels
+}
+
+func (s *SNSFilterPolicy) editHandler() {
+	editForm := NewSNSFilterPolicyForm(s.repo, s.subscriptionArn, s.policy, s.scope, s.app, func() {
+		s.Render()
+	})
+	s.app.AddAndSwitch(editForm)
+}
+
+func (s *SNSFilterPolicy) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'e', tcell.ModNone),
+			Description: "Edit",
+			Action:      s.editHandler,
+		},
+	}
+}
+
+func (s *SNSFilterPolicy) Render() {
+	policy, scope, err := s.repo.GetFilterPolicy(s.subscriptionArn)
+	if err != nil {
+		panic(err)
+	}
+	s.policy, s.scope = policy, scope
+	s.SetText(policy)
+}
@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var snsFilterPolicyScopes = []string{"MessageAttributes", "MessageBody"}
+
+type SNSFilterPolicyForm struct {
+	*tview.Form
+	view.SNS
+	repo            *repo.SNS
+	subscriptionArn string
+	app             *Application
+	onComplete      func()
+}
+
+func NewSNSFilterPolicyForm(repo *repo.SNS, subscriptionArn string, policy string, scope string, app *Application, onComplete func()) *SNSFilterPolicyForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Edit Filter Policy ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	s := &SNSFilterPolicyForm{
+		Form:            form,
+		repo:            repo,
+		subscriptionArn: subscriptionArn,
+		app:             app,
+		onComplete:      onComplete,
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(policy), "", "  "); err == nil {
+		policy = indented.String()
+	}
+	currentScope := 0
+	for i, v := range snsFilterPolicyScopes {
+		if v == scope {
+			currentScope = i
+		}
+	}
+
+	form.AddDropDown("Scope", snsFilterPolicyScopes, currentScope, nil)
+	form.AddTextArea("Policy", policy, 0, 16, 0, nil)
+	form.AddButton("Save", s.saveHandler)
+	form.AddButton("Cancel", s.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return s
+}
+
+func (s *SNSFilterPolicyForm) saveHandler() {
+	_, scope := s.GetFormItem(0).(*tview.DropDown).GetCurrentOption()
+	policy := strings.TrimSpace(s.GetFormItem(1).(*tview.TextArea).GetText())
+	if policy != "" {
+		var doc map[string]any
+		if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+			s.app.ShowError(s.GetService(), fmt.Sprintf("Filter policy is not a valid JSON object: %v", err))
+			return
+		}
+	}
+
+	if err := s.repo.SetFilterPolicy(s.subscriptionArn, policy, scope); err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Save filter policy failed: %v", err))
+		return
+	}
+
+	s.app.Close()
+	if s.onComplete != nil {
+		s.onComplete()
+	}
+}
+
+func (s *SNSFilterPolicyForm) cancelHandler() {
+	s.app.Close()
+}
+
+func (s SNSFilterPolicyForm) GetLabels() []string {
+	arn, err := arn.Parse(s.subscriptionArn)
+	if err != nil {
+		panic(err)
+	}
+	return []string{utils.GetResourceNameFromArn(arn), "Edit Filter Policy"}
+}
+
+func (s SNSFilterPolicyForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (s SNSFilterPolicyForm) Render() {
+}
@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	snsMessageFormatRaw         = "Raw"
+	snsMessageFormatPerProtocol = "JSON per protocol"
+)
+
+type SNSPublishForm struct {
+	*tview.Form
+	view.SNS
+	repo   *repo.SNS
+	topic  model.SNSTopic
+	name   string
+	isFifo bool
+	app    *Application
+}
+
+func NewSNSPublishForm(repo *repo.SNS, topic model.SNSTopic, app *Application) *SNSPublishForm {
+	name := topic.Arn
+	if a, err := arn.Parse(topic.Arn); err == nil {
+		name = utils.GetResourceNameFromArn(a)
+	}
+
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Publish to " + name + " ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	s := &SNSPublishForm{
+		Form:   form,
+		repo:   repo,
+		topic:  topic,
+		name:   name,
+		isFifo: topic.Attributes["FifoTopic"] == "true",
+		app:    app,
+	}
+
+	form.AddInputField("Subject", "", 0, nil, nil)
+	form.AddDropDown("Message Format", []string{snsMessageFormatRaw, snsMessageFormatPerProtocol}, 0, nil)
+	form.AddTextArea("Message", "", 0, 10, 0, nil)
+	form.AddTextArea("Message Attributes (JSON)", "", 0, 4, 0, nil)
+	if s.isFifo {
+		form.AddInputField("Message Group ID", "", 0, nil, nil)
+		form.AddInputField("Deduplication ID", "", 0, nil, nil)
+	}
+
+	form.AddButton("Publish", s.publishHandler)
+	form.AddButton("Cancel", s.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	// prefill a message structure when switching to per protocol, since it requires a default key
+	form.GetFormItem(1).(*tview.DropDown).SetSelectedFunc(func(option string, index int) {
+		messageArea := form.GetFormItem(2).(*tview.TextArea)
+		if option == snsMessageFormatPerProtocol && len(strings.TrimSpace(messageArea.GetText())) == 0 {
+			messageArea.SetText("{\n  \"default\": \"\"\n}", false)
+		}
+	})
+
+	return s
+}
+
+func (s *SNSPublishForm) publishHandler() {
+	subject := strings.TrimSpace(s.GetFormItem(0).(*tview.InputField).GetText())
+	_, format := s.GetFormItem(1).(*tview.DropDown).GetCurrentOption()
+	message := s.GetFormItem(2).(*tview.TextArea).GetText()
+	perProtocol := format == snsMessageFormatPerProtocol
+
+	if len(strings.TrimSpace(message)) == 0 {
+		s.app.ShowError(s.GetService(), "Message is required")
+		return
+	}
+	if perProtocol {
+		if err := utils.ValidateSNSMessageStructure(message); err != nil {
+			s.app.ShowError(s.GetService(), "Invalid message: "+err.Error())
+			return
+		}
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, []byte(message)); err == nil {
+			message = compacted.String()
+		}
+	}
+	attributes, err := utils.ParseSNSMessageAttributes(s.GetFormItem(3).(*tview.TextArea).GetText())
+	if err != nil {
+		s.app.ShowError(s.GetService(), "Invalid message attributes: "+err.Error())
+		return
+	}
+
+	var groupId, deduplicationId string
+	if s.isFifo {
+		groupId = strings.TrimSpace(s.GetFormItem(4).(*tview.InputField).GetText())
+		deduplicationId = strings.TrimSpace(s.GetFormItem(5).(*tview.InputField).GetText())
+		if len(groupId) == 0 {
+			s.app.ShowError(s.GetService(), "Message group ID is required for FIFO topics")
+			return
+		}
+	}
+
+	messageId, err := s.repo.Publish(s.topic.Arn, subject, message, perProtocol, attributes, groupId, deduplicationId)
+	if err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Publish failed: %v", err))
+		return
+	}
+
+	s.app.Close()
+	s.app.ShowMessage(s.GetService(), "Published message "+messageId)
+}
+
+func (s *SNSPublishForm) cancelHandler() {
+	s.app.Close()
+}
+
+func (s SNSPublishForm) GetLabels() []string {
+	return []string{s.name, "Publish"}
+}
+
+func (s SNSPublishForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (s SNSPublishForm) Render() {
+}
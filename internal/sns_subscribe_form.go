@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var snsSubscriptionProtocols = []string{
+	"sqs",
+	"lambda",
+	"https",
+	"http",
+	"email",
+	"email-json",
+	"sms",
+	"firehose",
+	"application",
+}
+
+type SNSSubscribeForm struct {
+	*tview.Form
+	view.SNS
+	repo       *repo.SNS
+	topicArn   string
+	app        *Application
+	onComplete func()
+}
+
+func NewSNSSubscribeForm(repo *repo.SNS, topicArn string, app *Application, onComplete func()) *SNSSubscribeForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Create Subscription ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	s := &SNSSubscribeForm{
+		Form:       form,
+		repo:       repo,
+		topicArn:   topicArn,
+		app:        app,
+		onComplete: onComplete,
+	}
+
+	form.AddDropDown("Protocol", snsSubscriptionProtocols, 0, nil)
+	form.AddInputField("Endpoint", "", 0, nil, nil)
+	form.AddCheckbox("Raw Message Delivery", false, nil)
+	form.AddTextArea("Filter Policy", "", 0, 8, 0, nil)
+	form.AddButton("Create", s.createHandler)
+	form.AddButton("Cancel", s.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return s
+}
+
+func (s *SNSSubscribeForm) createHandler() {
+	_, protocol := s.GetFormItem(0).(*tview.DropDown).GetCurrentOption()
+	endpoint := strings.TrimSpace(s.GetFormItem(1).(*tview.InputField).GetText())
+	if len(endpoint) == 0 {
+		s.app.ShowError(s.GetService(), "Endpoint is required")
+		return
+	}
+
+	attributes := make(map[string]string)
+	if s.GetFormItem(2).(*tview.Checkbox).IsChecked() {
+		attributes["RawMessageDelivery"] = "true"
+	}
+	if policy := strings.TrimSpace(s.GetFormItem(3).(*tview.TextArea).GetText()); policy != "" {
+		var doc map[string]any
+		if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+			s.app.ShowError(s.GetService(), fmt.Sprintf("Filter policy is not a valid JSON object: %v", err))
+			return
+		}
+		attributes["FilterPolicy"] = policy
+	}
+
+	if _, err := s.repo.Subscribe(s.topicArn, protocol, endpoint, attributes); err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Create subscription failed: %v", err))
+		return
+	}
+
+	s.app.Close()
+	if s.onComplete != nil {
+		s.onComplete()
+	}
+}
+
+func (s *SNSSubscribeForm) cancelHandler() {
+	s.app.Close()
+}
+
+func (s SNSSubscribeForm) GetLabels() []string {
+	arn, err := arn.Parse(s.topicArn)
+	if err != nil {
+		panic(err)
+	}
+	return []string{utils.GetResourceNameFromArn(arn), "Create Subscription"}
+}
+
+func (s SNSSubscribeForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (s SNSSubscribeForm) Render() {
+}
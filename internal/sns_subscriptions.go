@@ -1,11 +1,16 @@
 package internal
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/bporter816/aws-tui/internal/model"
 	"github.com/bporter816/aws-tui/internal/repo"
 	"github.com/bporter816/aws-tui/internal/ui"
 	"github.com/bporter816/aws-tui/internal/utils"
 	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
 )
 
 type SNSSubscriptions struct {
@@ -14,6 +19,7 @@ type SNSSubscriptions struct {
 	repo     *repo.SNS
 	topicArn string
 	app      *Application
+	model    []model.SNSSubscription
 }
 
 func NewSNSSubscriptions(repo *repo.SNS, topicArn string, app *Application) *SNSSubscriptions {
@@ -23,6 +29,7 @@ func NewSNSSubscriptions(repo *repo.SNS, topicArn string, app *Application) *SNS
 			"PROTOCOL",
 			"ENDPOINT",
 			"STATUS",
+			"FILTER POLICY",
 		}, 1, 0),
 		repo:     repo,
 		topicArn: topicArn,
@@ -39,25 +46,94 @@ func (s SNSSubscriptions) GetLabels() []string {
 	return []string{utils.GetResourceNameFromArn(arn), "Subscriptions"}
 }
 
-func (s SNSSubscriptions) GetKeyActions() []KeyAction {
-	return []KeyAction{}
+// getSelectedArn returns the selected subscription's ARN, which is not set until the subscription is confirmed
+func (s SNSSubscriptions) getSelectedArn() (string, bool) {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return "", false
+	}
+	subscriptionArn := utils.DerefString(s.model[row-1].SubscriptionArn, "")
+	if _, err := arn.Parse(subscriptionArn); err != nil {
+		s.app.ShowError(s.GetService(), "Subscription is pending confirmation")
+		return "", false
+	}
+	return subscriptionArn, true
+}
+
+func (s *SNSSubscriptions) createHandler() {
+	subscribeForm := NewSNSSubscribeForm(s.repo, s.topicArn, s.app, func() {
+		s.Render()
+	})
+	s.app.AddAndSwitch(subscribeForm)
+}
+
+func (s *SNSSubscriptions) deleteHandler() {
+	subscriptionArn, ok := s.getSelectedArn()
+	if !ok {
+		return
+	}
+	endpoint, _ := s.GetColSelection("ENDPOINT")
+	s.app.Confirm(s.GetService(), "Delete the subscription for "+endpoint+"?", "Delete", func() {
+		if err := s.repo.Unsubscribe(subscriptionArn); err != nil {
+			s.app.ShowError(s.GetService(), fmt.Sprintf("Delete subscription failed: %v", err))
+			return
+		}
+		s.Render()
+	})
+}
+
+func (s *SNSSubscriptions) filterPolicyHandler() {
+	subscriptionArn, ok := s.getSelectedArn()
+	if !ok {
+		return
+	}
+	filterPolicyView := NewSNSFilterPolicy(s.repo, subscriptionArn, s.app)
+	s.app.AddAndSwitch(filterPolicyView)
+}
+
+func (s *SNSSubscriptions) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'f', tcell.ModNone),
+			Description: "Filter Policy",
+			Action:      s.filterPolicyHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'c', tcell.ModNone),
+			Description: "Create",
+			Action:      s.createHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyBackspace, 0, tcell.ModNone),
+			Description: "Delete",
+			Action:      s.deleteHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyDelete, 0, tcell.ModNone),
+			Description: "Delete",
+			Action:      s.deleteHandler,
+		},
+	}
 }
 
-func (s SNSSubscriptions) Render() {
+func (s *SNSSubscriptions) Render() {
 	model, err := s.repo.ListSubscriptions(s.topicArn)
 	if err != nil {
 		panic(err)
 	}
+	s.model = model
 
 	var data [][]string
 	for _, v := range model {
 		var id, protocol, status string
 		if v.SubscriptionArn != nil {
-			arn, err := arn.Parse(*v.SubscriptionArn)
-			if err != nil {
-				panic(err)
+			// unconfirmed subscriptions have a placeholder instead of an ARN
+			if arn, err := arn.Parse(*v.SubscriptionArn); err == nil {
+				id = utils.GetResourceNameFromArn(arn)
+			} else {
+				id = "-"
+				status = utils.AutoCase(*v.SubscriptionArn)
 			}
-			id = utils.GetResourceNameFromArn(arn)
 		}
 		if v.Protocol != nil {
 			protocol = utils.AutoCase(*v.Protocol)
@@ -65,11 +141,15 @@ func (s SNSSubscriptions) Render() {
 		if s, ok := v.Attributes["PendingConfirmation"]; ok {
 			status = utils.BoolToString(s == "true", "Pending confirmation", "Confirmed")
 		}
+		// removing a filter policy leaves it set to an empty object
+		policy := strings.TrimSpace(v.Attributes["FilterPolicy"])
+		hasFilterPolicy := policy != "" && policy != "{}"
 		data = append(data, []string{
 			id,
 			protocol,
 			utils.DerefString(v.Endpoint, ""),
 			status,
+			utils.BoolToString(hasFilterPolicy, "Yes", "No"),
 		})
 	}
 	s.SetData(data)
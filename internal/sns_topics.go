@@ -46,6 +46,15 @@ func (s SNSTopics) subscriptionsHandler() {
 	s.app.AddAndSwitch(subscriptionsView)
 }
 
+func (s SNSTopics) publishHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	publishForm := NewSNSPublishForm(s.repo, s.model[row-1], s.app)
+	s.app.AddAndSwitch(publishForm)
+}
+
 func (s SNSTopics) accessControlPolicyHandler() {
 	row, err := s.GetRowSelection()
 	if err != nil {
@@ -80,6 +89,11 @@ func (s SNSTopics) GetKeyActions() []KeyAction {
 			Description: "Subscriptions",
 			Action:      s.subscriptionsHandler,
 		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'p', tcell.ModNone),
+			Description: "Publish",
+			Action:      s.publishHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone),
 			Description: "Access Control Policy",
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"strconv"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type SQSDeadLetterSourceQueues struct {
+	*ui.Table
+	view.SQS
+	repo  *repo.SQS
+	queue model.SQSQueue
+	app   *Application
+	model []model.SQSQueue
+}
+
+func NewSQSDeadLetterSourceQueues(repo *repo.SQS, queue model.SQSQueue, app *Application) *SQSDeadLetterSourceQueues {
+	s := &SQSDeadLetterSourceQueues{
+		Table: ui.NewTable([]string{
+			"NAME",
+			"TYPE",
+			"AVAILABLE",
+			"IN FLIGHT",
+			"MAX RECEIVES",
+		}, 1, 0),
+		repo:  repo,
+		queue: queue,
+		app:   app,
+	}
+	return s
+}
+
+func (s SQSDeadLetterSourceQueues) GetLabels() []string {
+	return []string{s.queue.Name, "Source Queues"}
+}
+
+func (s SQSDeadLetterSourceQueues) peekHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	openSQSMessages(s.GetService(), s.repo, s.model[row-1], s.app)
+}
+
+func (s SQSDeadLetterSourceQueues) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'p', tcell.ModNone),
+			Description: "Peek Messages",
+			Action:      s.peekHandler,
+		},
+	}
+}
+
+func (s *SQSDeadLetterSourceQueues) Render() {
+	model, err := s.repo.ListDeadLetterSourceQueues(s.queue.QueueUrl)
+	if err != nil {
+		panic(err)
+	}
+	s.model = model
+
+	var data [][]string
+	for _, v := range model {
+		data = append(data, []string{
+			v.Name,
+			utils.BoolToString(v.IsFifo, "FIFO", "Standard"),
+			strconv.FormatInt(v.MessagesAvailable, 10),
+			strconv.FormatInt(v.MessagesInFlight, 10),
+			strconv.FormatInt(v.MaxReceiveCount, 10),
+		})
+	}
+	s.SetData(data)
+}
@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"encoding/json"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+)
+
+type SQSMessage struct {
+	*ui.Text
+	view.SQS
+	queueName string
+	message   model.SQSMessage
+	app       *Application
+}
+
+func NewSQSMessage(queueName string, message model.SQSMessage, app *Application) *SQSMessage {
+	s := &SQSMessage{
+		Text:      ui.NewText(true, "json"),
+		queueName: queueName,
+		message:   message,
+		app:       app,
+	}
+	return s
+}
+
+func (s SQSMessage) GetLabels() []string {
+	return []string{utils.DerefString(s.message.MessageId, ""), "Message"}
+}
+
+func (s SQSMessage) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (s SQSMessage) Render() {
+	type messageAttribute struct {
+		DataType    string `json:"DataType"`
+		StringValue string `json:"StringValue,omitempty"`
+		BinaryValue []byte `json:"BinaryValue,omitempty"`
+	}
+	// embed JSON bodies as-is so they get pretty printed along with the attributes
+	bodyText := utils.DerefString(s.message.Body, "")
+	var body any = bodyText
+	if json.Valid([]byte(bodyText)) {
+		body = json.RawMessage(bodyText)
+	}
+	messageAttributes := make(map[string]messageAttribute)
+	for k, v := range s.message.MessageAttributes {
+		messageAttributes[k] = messageAttribute{
+			DataType:    utils.DerefString(v.DataType, ""),
+			StringValue: utils.DerefString(v.StringValue, ""),
+			BinaryValue: v.BinaryValue,
+		}
+	}
+	doc := struct {
+		MessageId         string                      `json:"MessageId"`
+		Body              any                         `json:"Body"`
+		Attributes        map[string]string           `json:"Attributes"`
+		MessageAttributes map[string]messageAttribute `json:"MessageAttributes,omitempty"`
+	}{
+		MessageId:         utils.DerefString(s.message.MessageId, ""),
+		Body:              body,
+		Attributes:        s.message.Attributes,
+		MessageAttributes: messageAttributes,
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		panic(err)
+	}
+	s.SetText(string(b))
+}
@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+const sqsMessageMoveTaskRunning = "RUNNING"
+
+type SQSMessageMoveTasks struct {
+	*ui.Table
+	view.SQS
+	repo       *repo.SQS
+	queue      model.SQSQueue
+	app        *Application
+	model      []model.SQSMessageMoveTask
+	refreshing bool
+}
+
+func NewSQSMessageMoveTasks(repo *repo.SQS, queue model.SQSQueue, app *Application) *SQSMessageMoveTasks {
+	s := &SQSMessageMoveTasks{
+		Table: ui.NewTable([]string{
+			"STATUS",
+			"DESTINATION",
+			"MOVED",
+			"TO MOVE",
+			"MAX PER SECOND",
+			"STARTED",
+			"FAILURE REASON",
+		}, 1, 0),
+		repo:  repo,
+		queue: queue,
+		app:   app,
+	}
+	return s
+}
+
+func (s SQSMessageMoveTasks) GetLabels() []string {
+	return []string{s.queue.Name, "Message Move Tasks"}
+}
+
+func (s *SQSMessageMoveTasks) startHandler() {
+	startForm := NewSQSStartMessageMoveTaskForm(s.repo, s.queue, s.app, func() {
+		s.Render()
+	})
+	s.app.AddAndSwitch(startForm)
+}
+
+func (s *SQSMessageMoveTasks) cancelHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	task := s.model[row-1]
+	if utils.DerefString(task.Status, "") != sqsMessageMoveTaskRunning || task.TaskHandle == nil {
+		s.app.ShowError(s.GetService(), "Only running tasks can be cancelled")
+		return
+	}
+	s.app.Confirm(s.GetService(), "Cancel the message move task from "+s.queue.Name+"? Messages already moved stay moved.", "Cancel Task", func() {
+		if err := s.repo.CancelMessageMoveTask(*task.TaskHandle); err != nil {
+			s.app.ShowError(s.GetService(), fmt.Sprintf("Cancel failed: %v", err))
+			return
+		}
+		s.Render()
+	})
+}
+
+func (s *SQSMessageMoveTasks) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone),
+			Description: "Start Move Task",
+			Action:      s.startHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'c', tcell.ModNone),
+			Description: "Cancel Move Task",
+			Action:      s.cancelHandler,
+		},
+	}
+}
+
+func (s SQSMessageMoveTasks) hasRunningTasks() bool {
+	for _, v := range s.model {
+		if utils.DerefString(v.Status, "") == sqsMessageMoveTaskRunning {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *SQSMessageMoveTasks) Render() {
+	model, err := s.repo.ListMessageMoveTasks(s.queue.Arn)
+	if err != nil {
+		panic(err)
+	}
+	s.model = model
+
+	var data [][]string
+	for _, v := range model {
+		destination := "Original source"
+		if v.DestinationArn != nil {
+			if a, err := arn.Parse(*v.DestinationArn); err == nil {
+				destination = a.Resource
+			}
+		}
+		toMove := "-"
+		if v.ApproximateNumberOfMessagesToMove != nil {
+			toMove = strconv.FormatInt(*v.ApproximateNumberOfMessagesToMove, 10)
+		}
+		maxPerSecond := "Optimized"
+		if v.MaxNumberOfMessagesPerSecond != nil {
+			maxPerSecond = strconv.Itoa(int(*v.MaxNumberOfMessagesPerSecond))
+		}
+		data = append(data, []string{
+			utils.AutoCase(utils.DerefString(v.Status, "")),
+			destination,
+			strconv.FormatInt(v.ApproximateNumberOfMessagesMoved, 10),
+			toMove,
+			maxPerSecond,
+			time.UnixMilli(v.StartedTimestamp).Format(utils.DefaultTimeFormat),
+			utils.DerefString(v.FailureReason, "-"),
+		})
+	}
+	s.SetData(data)
+
+	// keep the progress up to date while a task is running
+	if !s.refreshing && s.hasRunningTasks() {
+		s.refreshing = true
+		s.app.AutoRefresh(s, 5*time.Second, func() bool {
+			s.refreshing = s.hasRunningTasks()
+			return s.refreshing
+		})
+	}
+}
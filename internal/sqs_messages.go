@@ -0,0 +1,158 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sqsTypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type SQSMessages struct {
+	*ui.Table
+	view.SQS
+	repo   *repo.SQS
+	queue  model.SQSQueue
+	app    *Application
+	model  []model.SQSMessage
+	peeked bool
+}
+
+func NewSQSMessages(repo *repo.SQS, queue model.SQSQueue, app *Application) *SQSMessages {
+	s := &SQSMessages{
+		Table: ui.NewTable([]string{
+			"MESSAGE ID",
+			"SENT",
+			"RECEIVE COUNT",
+			"GROUP ID",
+			"SIZE",
+			"BODY",
+		}, 1, 0),
+		repo:  repo,
+		queue: queue,
+		app:   app,
+	}
+	return s
+}
+
+// openSQSMessages pushes the messages view for a queue. Every peek counts as a receive, so queues with a redrive policy
+// get a confirmation first.
+func openSQSMessages(service string, repo *repo.SQS, queue model.SQSQueue, app *Application) {
+	open := func() {
+		app.AddAndSwitch(NewSQSMessages(repo, queue, app))
+	}
+	if queue.MaxReceiveCount == 0 {
+		open()
+		return
+	}
+	app.Confirm(service, sqsPeekWarning(queue), "Peek", open)
+}
+
+func sqsPeekWarning(queue model.SQSQueue) string {
+	return fmt.Sprintf(
+		"Peeking counts as a receive for each message. Messages in %v that reach %v receives are moved to its dead-letter queue. Peek anyway?",
+		queue.Name,
+		queue.MaxReceiveCount,
+	)
+}
+
+func (s SQSMessages) GetLabels() []string {
+	return []string{s.queue.Name, "Messages"}
+}
+
+func (s SQSMessages) messageHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	messageView := NewSQSMessage(s.queue.Name, s.model[row-1], s.app)
+	s.app.AddAndSwitch(messageView)
+}
+
+func (s *SQSMessages) moveBackHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	sourceQueues, err := s.repo.ListDeadLetterSourceQueues(s.queue.QueueUrl)
+	if err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Failed to list source queues: %v", err))
+		return
+	}
+	if len(sourceQueues) == 0 {
+		s.app.ShowError(s.GetService(), s.queue.Name+" is not a dead-letter queue for any queue")
+		return
+	}
+	moveForm := NewSQSMoveMessageForm(s.repo, s.queue, s.model[row-1], sourceQueues, s.app, func() {
+		// drop the moved message rather than peeking again, which would count as another receive
+		s.model = append(s.model[:row-1], s.model[row:]...)
+		s.setMessages()
+	})
+	s.app.AddAndSwitch(moveForm)
+}
+
+func (s *SQSMessages) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'm', tcell.ModNone),
+			Description: "Move Back",
+			Action:      s.moveBackHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone),
+			Description: "View Message",
+			Action:      s.messageHandler,
+		},
+	}
+}
+
+// Render peeks again, which counts as another receive for every message, so queues with a redrive policy get a
+// confirmation after the first peek
+func (s *SQSMessages) Render() {
+	if !s.peeked || s.queue.MaxReceiveCount == 0 {
+		s.peek()
+		return
+	}
+	s.app.Confirm(s.GetService(), sqsPeekWarning(s.queue), "Peek", s.peek)
+}
+
+func (s *SQSMessages) peek() {
+	model, err := s.repo.PeekMessages(s.queue.QueueUrl)
+	if err != nil {
+		panic(err)
+	}
+	s.model = model
+	s.peeked = true
+	s.setMessages()
+}
+
+func (s *SQSMessages) setMessages() {
+	var data [][]string
+	for _, v := range s.model {
+		var sent string
+		if ts, err := strconv.ParseInt(v.Attributes[string(sqsTypes.MessageSystemAttributeNameSentTimestamp)], 10, 64); err == nil {
+			sent = time.UnixMilli(ts).Format(utils.DefaultTimeFormat)
+		}
+		groupId, ok := v.Attributes[string(sqsTypes.MessageSystemAttributeNameMessageGroupId)]
+		if !ok {
+			groupId = "-"
+		}
+		body := utils.DerefString(v.Body, "")
+		data = append(data, []string{
+			utils.DerefString(v.MessageId, ""),
+			sent,
+			v.Attributes[string(sqsTypes.MessageSystemAttributeNameApproximateReceiveCount)],
+			groupId,
+			utils.FormatSize(int64(len(body)), 1),
+			strings.Join(strings.Fields(body), " "),
+		})
+	}
+	s.SetData(data)
+}
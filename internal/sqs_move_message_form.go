@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type SQSMoveMessageForm struct {
+	*tview.Form
+	view.SQS
+	repo         *repo.SQS
+	queue        model.SQSQueue
+	message      model.SQSMessage
+	sourceQueues []model.SQSQueue
+	app          *Application
+	onComplete   func()
+}
+
+func NewSQSMoveMessageForm(repo *repo.SQS, queue model.SQSQueue, message model.SQSMessage, sourceQueues []model.SQSQueue, app *Application, onComplete func()) *SQSMoveMessageForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Move Message Back ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	s := &SQSMoveMessageForm{
+		Form:         form,
+		repo:         repo,
+		queue:        queue,
+		message:      message,
+		sourceQueues: sourceQueues,
+		app:          app,
+		onComplete:   onComplete,
+	}
+
+	var names []string
+	for _, v := range sourceQueues {
+		names = append(names, v.Name)
+	}
+	form.AddTextView("Message", utils.DerefString(message.MessageId, ""), 0, 1, false, false)
+	form.AddDropDown("Destination", names, 0, nil)
+	form.AddButton("Move", s.moveHandler)
+	form.AddButton("Cancel", s.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return s
+}
+
+func (s *SQSMoveMessageForm) moveHandler() {
+	index, _ := s.GetFormItem(1).(*tview.DropDown).GetCurrentOption()
+	if index < 0 {
+		return
+	}
+	if err := s.repo.MoveMessage(s.message, s.queue.QueueUrl, s.sourceQueues[index]); err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Move message failed: %v", err))
+		return
+	}
+
+	s.app.Close()
+	if s.onComplete != nil {
+		s.onComplete()
+	}
+}
+
+func (s *SQSMoveMessageForm) cancelHandler() {
+	s.app.Close()
+}
+
+func (s SQSMoveMessageForm) GetLabels() []string {
+	return []string{utils.DerefString(s.message.MessageId, ""), "Move Back"}
+}
+
+func (s SQSMoveMessageForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (s SQSMoveMessageForm) Render() {
+}
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type SQSPurgeQueueForm struct {
+	*tview.Form
+	view.SQS
+	repo       *repo.SQS
+	queue      model.SQSQueue
+	app        *Application
+	onComplete func()
+}
+
+func NewSQSPurgeQueueForm(repo *repo.SQS, queue model.SQSQueue, app *Application, onComplete func()) *SQSPurgeQueueForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Purge Queue ")
+	form.SetTitleColor(tcell.ColorRed)
+
+	s := &SQSPurgeQueueForm{
+		Form:       form,
+		repo:       repo,
+		queue:      queue,
+		app:        app,
+		onComplete: onComplete,
+	}
+
+	form.AddTextView("Warning", "All messages in "+queue.Name+" will be deleted. This cannot be undone.", 0, 2, true, false)
+	form.AddInputField("Type the queue name to confirm", "", 0, nil, nil)
+	form.AddButton("Purge", s.purgeHandler)
+	form.AddButton("Cancel", s.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorRed)
+	form.SetButtonTextColor(tcell.ColorWhite)
+
+	return s
+}
+
+func (s *SQSPurgeQueueForm) purgeHandler() {
+	if s.GetFormItem(1).(*tview.InputField).GetText() != s.queue.Name {
+		s.app.ShowError(s.GetService(), "Queue name does not match")
+		return
+	}
+	if err := s.repo.PurgeQueue(s.queue.QueueUrl); err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Purge failed: %v", err))
+		return
+	}
+
+	s.app.Close()
+	if s.onComplete != nil {
+		s.onComplete()
+	}
+}
+
+func (s *SQSPurgeQueueForm) cancelHandler() {
+	s.app.Close()
+}
+
+func (s SQSPurgeQueueForm) GetLabels() []string {
+	return []string{s.queue.Name, "Purge"}
+}
+
+func (s SQSPurgeQueueForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (s SQSPurgeQueueForm) Render() {
+}
@@ -1,6 +1,10 @@
 package internal
 
 import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/bporter816/aws-tui/internal/model"
 	"github.com/bporter816/aws-tui/internal/repo"
 	"github.com/bporter816/aws-tui/internal/ui"
@@ -22,6 +26,12 @@ func NewSQSQueues(repo *repo.SQS, app *Application) *SQSQueues {
 		Table: ui.NewTable([]string{
 			"NAME",
 			"TYPE",
+			"AVAILABLE",
+			"IN FLIGHT",
+			"DELAYED",
+			"VISIBILITY TIMEOUT",
+			"RETENTION",
+			"DEAD-LETTER QUEUE",
 		}, 1, 0),
 		repo: repo,
 		app:  app,
@@ -42,6 +52,73 @@ func (s SQSQueues) accessPolicyHandler() {
 	s.app.AddAndSwitch(accessPolicyView)
 }
 
+func (s SQSQueues) peekHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	openSQSMessages(s.GetService(), s.repo, s.model[row-1], s.app)
+}
+
+func (s *SQSQueues) sendHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	sendForm := NewSQSSendMessageForm(s.repo, s.model[row-1], s.app, func() {
+		s.Render()
+	})
+	s.app.AddAndSwitch(sendForm)
+}
+
+func (s *SQSQueues) purgeHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	purgeForm := NewSQSPurgeQueueForm(s.repo, s.model[row-1], s.app, func() {
+		s.Render()
+	})
+	s.app.AddAndSwitch(purgeForm)
+}
+
+// deadLetterQueueHandler jumps to the row of the selected queue's DLQ
+func (s SQSQueues) deadLetterQueueHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	target := s.model[row-1].DeadLetterTargetArn
+	if target == "" {
+		return
+	}
+	for i, v := range s.model {
+		if v.Arn == target {
+			s.Select(i+1, 0)
+			return
+		}
+	}
+	s.app.ShowError(s.GetService(), "Dead-letter queue "+target+" is not in this region")
+}
+
+func (s SQSQueues) sourceQueuesHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	sourceQueuesView := NewSQSDeadLetterSourceQueues(s.repo, s.model[row-1], s.app)
+	s.app.AddAndSwitch(sourceQueuesView)
+}
+
+func (s SQSQueues) moveTasksHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	moveTasksView := NewSQSMessageMoveTasks(s.repo, s.model[row-1], s.app)
+	s.app.AddAndSwitch(moveTasksView)
+}
+
 func (s SQSQueues) tagsHandler() {
 	row, err := s.GetRowSelection()
 	if err != nil {
@@ -51,8 +128,38 @@ func (s SQSQueues) tagsHandler() {
 	s.app.AddAndSwitch(tagsView)
 }
 
-func (s SQSQueues) GetKeyActions() []KeyAction {
+func (s *SQSQueues) GetKeyActions() []KeyAction {
 	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'p', tcell.ModNone),
+			Description: "Peek Messages",
+			Action:      s.peekHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 's', tcell.ModNone),
+			Description: "Send Message",
+			Action:      s.sendHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'P', tcell.ModNone),
+			Description: "Purge",
+			Action:      s.purgeHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'd', tcell.ModNone),
+			Description: "Go to Dead-Letter Queue",
+			Action:      s.deadLetterQueueHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'S', tcell.ModNone),
+			Description: "Source Queues",
+			Action:      s.sourceQueuesHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'm', tcell.ModNone),
+			Description: "Message Move Tasks",
+			Action:      s.moveTasksHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone),
 			Description: "Access Policy",
@@ -75,9 +182,19 @@ func (s *SQSQueues) Render() {
 
 	var data [][]string
 	for _, v := range model {
+		deadLetterQueue := "-"
+		if a, err := arn.Parse(v.DeadLetterTargetArn); err == nil {
+			deadLetterQueue = fmt.Sprintf("%v (max %v receives)", a.Resource, v.MaxReceiveCount)
+		}
 		data = append(data, []string{
 			v.Name,
 			utils.BoolToString(v.IsFifo, "FIFO", "Standard"),
+			strconv.FormatInt(v.MessagesAvailable, 10),
+			strconv.FormatInt(v.MessagesInFlight, 10),
+			strconv.FormatInt(v.MessagesDelayed, 10),
+			utils.FormatSeconds(v.VisibilityTimeoutSeconds),
+			utils.FormatSeconds(v.RetentionPeriodSeconds),
+			deadLetterQueue,
 		})
 	}
 	s.SetData(data)
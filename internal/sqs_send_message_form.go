@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type SQSSendMessageForm struct {
+	*tview.Form
+	view.SQS
+	repo       *repo.SQS
+	queue      model.SQSQueue
+	app        *Application
+	onComplete func()
+}
+
+func NewSQSSendMessageForm(repo *repo.SQS, queue model.SQSQueue, app *Application, onComplete func()) *SQSSendMessageForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Send Message to " + queue.Name + " ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	s := &SQSSendMessageForm{
+		Form:       form,
+		repo:       repo,
+		queue:      queue,
+		app:        app,
+		onComplete: onComplete,
+	}
+
+	form.AddTextArea("Body", "{}", 0, 12, 0, nil)
+	form.AddCheckbox("Validate JSON", true, nil)
+	if queue.IsFifo {
+		form.AddInputField("Message Group ID", "", 0, nil, nil)
+		form.AddInputField("Deduplication ID", "", 0, nil, nil)
+	}
+
+	form.AddButton("Send", s.sendHandler)
+	form.AddButton("Cancel", s.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return s
+}
+
+func (s *SQSSendMessageForm) sendHandler() {
+	body := s.GetFormItem(0).(*tview.TextArea).GetText()
+	if len(strings.TrimSpace(body)) == 0 {
+		s.app.ShowError(s.GetService(), "Message body is required")
+		return
+	}
+	if s.GetFormItem(1).(*tview.Checkbox).IsChecked() && !json.Valid([]byte(body)) {
+		s.app.ShowError(s.GetService(), "Message body is not valid JSON")
+		return
+	}
+
+	var groupId, deduplicationId string
+	if s.queue.IsFifo {
+		groupId = strings.TrimSpace(s.GetFormItem(2).(*tview.InputField).GetText())
+		deduplicationId = strings.TrimSpace(s.GetFormItem(3).(*tview.InputField).GetText())
+		if len(groupId) == 0 {
+			s.app.ShowError(s.GetService(), "Message group ID is required for FIFO queues")
+			return
+		}
+	}
+
+	if _, err := s.repo.SendMessage(s.queue.QueueUrl, body, groupId, deduplicationId); err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Send message failed: %v", err))
+		return
+	}
+
+	s.app.Close()
+	if s.onComplete != nil {
+		s.onComplete()
+	}
+}
+
+func (s *SQSSendMessageForm) cancelHandler() {
+	s.app.Close()
+}
+
+func (s SQSSendMessageForm) GetLabels() []string {
+	return []string{s.queue.Name, "Send Message"}
+}
+
+func (s SQSSendMessageForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (s SQSSendMessageForm) Render() {
+}
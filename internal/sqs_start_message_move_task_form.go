@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type SQSStartMessageMoveTaskForm struct {
+	*tview.Form
+	view.SQS
+	repo         *repo.SQS
+	queue        model.SQSQueue
+	sourceQueues []model.SQSQueue
+	app          *Application
+	onComplete   func()
+}
+
+func NewSQSStartMessageMoveTaskForm(repo *repo.SQS, queue model.SQSQueue, app *Application, onComplete func()) *SQSStartMessageMoveTaskForm {
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(" Start Message Move Task ")
+	form.SetTitleColor(tcell.ColorGreen)
+
+	s := &SQSStartMessageMoveTaskForm{
+		Form:       form,
+		repo:       repo,
+		queue:      queue,
+		app:        app,
+		onComplete: onComplete,
+	}
+
+	form.AddTextView("Source", queue.Name, 0, 1, false, false)
+	form.AddDropDown("Destination", []string{"Original source queues"}, 0, nil)
+	form.AddInputField("Max Messages Per Second", "", 10, tview.InputFieldInteger, nil)
+	form.AddButton("Start", s.startHandler)
+	form.AddButton("Cancel", s.cancelHandler)
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	return s
+}
+
+func (s *SQSStartMessageMoveTaskForm) startHandler() {
+	var destinationArn string
+	if index, _ := s.GetFormItem(1).(*tview.DropDown).GetCurrentOption(); index > 0 {
+		destinationArn = s.sourceQueues[index-1].Arn
+	}
+	var maxPerSecond int32
+	if v := strings.TrimSpace(s.GetFormItem(2).(*tview.InputField).GetText()); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 1 || n > 500 {
+			s.app.ShowError(s.GetService(), "Max messages per second must be between 1 and 500")
+			return
+		}
+		maxPerSecond = int32(n)
+	}
+
+	if _, err := s.repo.StartMessageMoveTask(s.queue.Arn, destinationArn, maxPerSecond); err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Start message move task failed: %v", err))
+		return
+	}
+
+	s.app.Close()
+	if s.onComplete != nil {
+		s.onComplete()
+	}
+}
+
+func (s *SQSStartMessageMoveTaskForm) cancelHandler() {
+	s.app.Close()
+}
+
+func (s SQSStartMessageMoveTaskForm) GetLabels() []string {
+	return []string{s.queue.Name, "Start Move Task"}
+}
+
+func (s SQSStartMessageMoveTaskForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (s *SQSStartMessageMoveTaskForm) Render() {
+	sourceQueues, err := s.repo.ListDeadLetterSourceQueues(s.queue.QueueUrl)
+	if err != nil {
+		panic(err)
+	}
+	s.sourceQueues = sourceQueues
+
+	options := []string{"Original source queues"}
+	for _, v := range sourceQueues {
+		options = append(options, v.Name)
+	}
+	s.GetFormItem(1).(*tview.DropDown).SetOptions(options, nil)
+	s.GetFormItem(1).(*tview.DropDown).SetCurrentOption(0)
+}
@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	ssmTypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type SSMParameterHistory struct {
+	*ui.Table
+	view.SSM
+	repo      *repo.SSM
+	app       *Application
+	name      string
+	model     []model.SSMParameterHistory
+	decrypted bool
+	masked    *maskedValues
+}
+
+func NewSSMParameterHistory(repo *repo.SSM, name string, app *Application) *SSMParameterHistory {
+	s := &SSMParameterHistory{
+		Table: ui.NewTable([]string{
+			"VERSION",
+			"TYPE",
+			"TIER",
+			"LAST MODIFIED",
+			"MODIFIED BY",
+			"LABELS",
+			"VALUE",
+		}, 1, 0),
+		repo:   repo,
+		app:    app,
+		name:   name,
+		masked: newMaskedValues(),
+	}
+	return s
+}
+
+func (s SSMParameterHistory) GetLabels() []string {
+	return []string{s.name, "History"}
+}
+
+func (s *SSMParameterHistory) revealHandler() {
+	row, err := s.GetRowSelection()
+	if err != nil {
+		return
+	}
+	v := s.model[row-1]
+	if v.Type != ssmTypes.ParameterTypeSecureString {
+		return
+	}
+	// decrypt the whole history the first time any value is revealed
+	if !s.decrypted {
+		model, err := s.repo.GetParameterHistory(s.name, true)
+		if err != nil {
+			s.app.ShowError(s.GetService(), fmt.Sprintf("Decrypt failed: %v", err))
+			return
+		}
+		s.decrypted = true
+		s.setModel(model)
+	}
+	s.masked.Toggle(strconv.FormatInt(v.Version, 10))
+	s.setData()
+}
+
+func (s *SSMParameterHistory) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone),
+			Description: "Reveal/Hide Value",
+			Action:      s.revealHandler,
+		},
+	}
+}
+
+func (s *SSMParameterHistory) setModel(model []model.SSMParameterHistory) {
+	// newest first
+	sort.Slice(model, func(i, j int) bool {
+		return model[i].Version > model[j].Version
+	})
+	s.model = model
+}
+
+func (s *SSMParameterHistory) setData() {
+	var data [][]string
+	for _, v := range s.model {
+		var lastModified string
+		if v.LastModifiedDate != nil {
+			lastModified = v.LastModifiedDate.Format(utils.DefaultTimeFormat)
+		}
+		labels := "-"
+		if len(v.Labels) > 0 {
+			labels = strings.Join(v.Labels, ", ")
+		}
+		version := strconv.FormatInt(v.Version, 10)
+		value := utils.DerefString(v.Value, "")
+		if v.Type == ssmTypes.ParameterTypeSecureString {
+			value = s.masked.Format(version, value)
+		}
+		var modifiedBy string
+		if v.LastModifiedUser != nil {
+			modifiedBy = *v.LastModifiedUser
+			if i := strings.LastIndex(modifiedBy, "/"); i >= 0 {
+				modifiedBy = modifiedBy[i+1:]
+			}
+		}
+		data = append(data, []string{
+			version,
+			string(v.Type),
+			string(v.Tier),
+			lastModified,
+			modifiedBy,
+			labels,
+			value,
+		})
+	}
+	s.SetData(data)
+}
+
+func (s *SSMParameterHistory) Render() {
+	model, err := s.repo.GetParameterHistory(s.name, s.decrypted)
+	if err != nil {
+		panic(err)
+	}
+	s.setModel(model)
+	s.setData()
+}
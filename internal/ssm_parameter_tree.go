@@ -0,0 +1,226 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	ssmTypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+type SSMParameterTree struct {
+	*ui.Tree
+	view.SSM
+	repo    *repo.SSM
+	kmsRepo *repo.KMS
+	app     *Application
+	names   []string
+	types   map[string]ssmTypes.ParameterType
+}
+
+func NewSSMParameterTree(repo *repo.SSM, kmsRepo *repo.KMS, app *Application) *SSMParameterTree {
+	root := tview.NewTreeNode("/")
+	root.SetReference("/")
+
+	s := &SSMParameterTree{
+		Tree:    ui.NewTree(root),
+		repo:    repo,
+		kmsRepo: kmsRepo,
+		app:     app,
+	}
+	s.SetSelectedFunc(s.selectHandler)
+	return s
+}
+
+func (s SSMParameterTree) GetLabels() []string {
+	return []string{"Parameter Hierarchy"}
+}
+
+func (s *SSMParameterTree) selectHandler(n *tview.TreeNode) {
+	s.expandDir(n)
+}
+
+// getSelectedParameter returns the name of the selected parameter, if a parameter rather than a path is selected
+func (s SSMParameterTree) getSelectedParameter() (string, bool) {
+	node := s.GetCurrentNode()
+	if node == nil {
+		return "", false
+	}
+	name := node.GetReference().(string)
+	if strings.HasSuffix(name, "/") {
+		return "", false
+	}
+	return name, true
+}
+
+func (s SSMParameterTree) valueHandler() {
+	if name, ok := s.getSelectedParameter(); ok {
+		valueView := NewSSMParameterValue(s.repo, name, s.app)
+		s.app.AddAndSwitch(valueView)
+	}
+}
+
+func (s SSMParameterTree) historyHandler() {
+	if name, ok := s.getSelectedParameter(); ok {
+		historyView := NewSSMParameterHistory(s.repo, name, s.app)
+		s.app.AddAndSwitch(historyView)
+	}
+}
+
+func (s *SSMParameterTree) putHandler() {
+	// default the new parameter to the selected path
+	path := "/"
+	if node := s.GetCurrentNode(); node != nil {
+		ref := node.GetReference().(string)
+		path = ref[:strings.LastIndex(ref, "/")+1]
+	}
+	putForm := NewSSMPutParameterForm(s.repo, s.kmsRepo, path, nil, "", s.app, func() {
+		s.Render()
+	})
+	s.app.AddAndSwitch(putForm)
+}
+
+func (s *SSMParameterTree) editHandler() {
+	name, ok := s.getSelectedParameter()
+	if !ok {
+		return
+	}
+	metadata, err := s.repo.DescribeParameter(name)
+	if err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Failed to describe parameter: %v", err))
+		return
+	}
+	value, err := s.repo.GetParameter(name, true)
+	if err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Failed to get parameter: %v", err))
+		return
+	}
+	putForm := NewSSMPutParameterForm(s.repo, s.kmsRepo, name, &metadata, utils.DerefString(value.Value, ""), s.app, func() {
+		s.Render()
+	})
+	s.app.AddAndSwitch(putForm)
+}
+
+func (s SSMParameterTree) tagsHandler() {
+	if name, ok := s.getSelectedParameter(); ok {
+		tagsView := NewTags(s.repo, s.GetService(), "parameter:"+name, s.app)
+		s.app.AddAndSwitch(tagsView)
+	}
+}
+
+func (s *SSMParameterTree) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone),
+			Description: "Value",
+			Action:      s.valueHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'h', tcell.ModNone),
+			Description: "History",
+			Action:      s.historyHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'p', tcell.ModNone),
+			Description: "Put Parameter",
+			Action:      s.putHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'e', tcell.ModNone),
+			Description: "Edit",
+			Action:      s.editHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
+			Description: "Tags",
+			Action:      s.tagsHandler,
+		},
+	}
+}
+
+func (s *SSMParameterTree) expandDir(n *tview.TreeNode) {
+	ref := n.GetReference().(string)
+	if !strings.HasSuffix(ref, "/") {
+		return
+	}
+	if len(n.GetChildren()) > 0 {
+		n.SetExpanded(!n.IsExpanded())
+		return
+	}
+
+	dirs, leaves := utils.GetSSMPathChildren(ref, s.names)
+	for _, dir := range dirs {
+		c := tview.NewTreeNode(strings.TrimPrefix(dir, ref))
+		c.SetColor(tcell.ColorGreen)
+		c.SetReference(dir)
+		n.AddChild(c)
+	}
+	for _, leaf := range leaves {
+		c := tview.NewTreeNode(strings.TrimPrefix(leaf, ref))
+		if s.types[leaf] == ssmTypes.ParameterTypeSecureString {
+			c.SetColor(tcell.ColorYellow)
+		}
+		c.SetReference(leaf)
+		n.AddChild(c)
+	}
+}
+
+func (s *SSMParameterTree) Render() {
+	// GetParametersByPath doesn't return sub-paths, so list the hierarchy once and expand paths from it
+	parameters, err := s.repo.ListParametersByPath("/", true)
+	if err != nil {
+		panic(err)
+	}
+	s.names = nil
+	s.types = make(map[string]ssmTypes.ParameterType)
+	for _, v := range parameters {
+		if v.Name != nil {
+			s.names = append(s.names, *v.Name)
+			s.types[*v.Name] = v.Type
+		}
+	}
+
+	// keep the expanded paths and the selection across re-renders
+	root := s.GetRoot()
+	expanded := make(map[string]bool)
+	getExpandedPaths(root, expanded)
+	var selected string
+	if node := s.GetCurrentNode(); node != nil {
+		selected = node.GetReference().(string)
+	}
+
+	root.ClearChildren()
+	s.restoreDir(root, expanded)
+	s.SetCurrentNode(root)
+	root.Walk(func(node, parent *tview.TreeNode) bool {
+		if node.GetReference().(string) == selected {
+			s.SetCurrentNode(node)
+			return false
+		}
+		return true
+	})
+}
+
+func getExpandedPaths(n *tview.TreeNode, paths map[string]bool) {
+	if n.IsExpanded() && len(n.GetChildren()) > 0 {
+		paths[n.GetReference().(string)] = true
+	}
+	for _, c := range n.GetChildren() {
+		getExpandedPaths(c, paths)
+	}
+}
+
+// restoreDir loads the children of a path and re-expands those that were expanded before
+func (s *SSMParameterTree) restoreDir(n *tview.TreeNode, expanded map[string]bool) {
+	s.expandDir(n)
+	for _, c := range n.GetChildren() {
+		if expanded[c.GetReference().(string)] {
+			s.restoreDir(c, expanded)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+
+	ssmTypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/ui"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+)
+
+type SSMParameterValue struct {
+	*ui.Table
+	view.SSM
+	repo      *repo.SSM
+	app       *Application
+	name      string
+	model     model.SSMParameterValue
+	decrypted bool
+	masked    *maskedValues
+}
+
+func NewSSMParameterValue(repo *repo.SSM, name string, app *Application) *SSMParameterValue {
+	s := &SSMParameterValue{
+		Table: ui.NewTable([]string{
+			"KEY",
+			"VALUE",
+		}, 1, 0),
+		repo:   repo,
+		app:    app,
+		name:   name,
+		masked: newMaskedValues(),
+	}
+	return s
+}
+
+func (s SSMParameterValue) GetLabels() []string {
+	return []string{s.name, "Value"}
+}
+
+func (s SSMParameterValue) isSecure() bool {
+	return s.model.Type == ssmTypes.ParameterTypeSecureString
+}
+
+// decrypt fetches the decrypted value of a SecureString parameter the first time it is needed
+func (s *SSMParameterValue) decrypt() bool {
+	if !s.isSecure() || s.decrypted {
+		return true
+	}
+	model, err := s.repo.GetParameter(s.name, true)
+	if err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Decrypt failed: %v", err))
+		return false
+	}
+	s.model = model
+	s.decrypted = true
+	return true
+}
+
+func (s *SSMParameterValue) revealHandler() {
+	if !s.isSecure() {
+		return
+	}
+	if !s.masked.IsRevealed("") && !s.decrypt() {
+		return
+	}
+	s.masked.Toggle("")
+	s.setData()
+}
+
+func (s *SSMParameterValue) copyHandler() {
+	if !s.decrypt() {
+		return
+	}
+	s.app.CopyToClipboard(s.GetService(), utils.DerefString(s.model.Value, ""))
+}
+
+func (s *SSMParameterValue) GetKeyActions() []KeyAction {
+	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'r', tcell.ModNone),
+			Description: "Reveal/Hide Value",
+			Action:      s.revealHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'y', tcell.ModNone),
+			Description: "Copy Value",
+			Action:      s.copyHandler,
+		},
+	}
+}
+
+func (s *SSMParameterValue) setData() {
+	value := utils.DerefString(s.model.Value, "")
+	if s.isSecure() {
+		value = s.masked.Format("", value)
+	}
+	var lastModified string
+	if s.model.LastModifiedDate != nil {
+		lastModified = s.model.LastModifiedDate.Format(utils.DefaultTimeFormat)
+	}
+	data := [][]string{
+		{"Name", utils.DerefString(s.model.Name, "")},
+		{"Type", string(s.model.Type)},
+		{"Data Type", utils.DerefString(s.model.DataType, "")},
+		{"Version", strconv.FormatInt(s.model.Version, 10)},
+		{"Last Modified", lastModified},
+		{"Value", value},
+	}
+	s.SetData(data)
+}
+
+func (s *SSMParameterValue) Render() {
+	// SecureString values stay encrypted until they are revealed or copied
+	model, err := s.repo.GetParameter(s.name, s.decrypted)
+	if err != nil {
+		panic(err)
+	}
+	s.model = model
+	s.setData()
+}
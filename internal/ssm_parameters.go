@@ -50,8 +50,36 @@ func (s SSMParameters) tagsHandler() {
 	}
 }
 
+func (s SSMParameters) valueHandler() {
+	name, err := s.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	valueView := NewSSMParameterValue(s.repo, name, s.app)
+	s.app.AddAndSwitch(valueView)
+}
+
+func (s SSMParameters) historyHandler() {
+	name, err := s.GetColSelection("NAME")
+	if err != nil {
+		return
+	}
+	historyView := NewSSMParameterHistory(s.repo, name, s.app)
+	s.app.AddAndSwitch(historyView)
+}
+
 func (s SSMParameters) GetKeyActions() []KeyAction {
 	return []KeyAction{
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'v', tcell.ModNone),
+			Description: "Value",
+			Action:      s.valueHandler,
+		},
+		{
+			Key:         tcell.NewEventKey(tcell.KeyRune, 'h', tcell.ModNone),
+			Description: "History",
+			Action:      s.historyHandler,
+		},
 		{
 			Key:         tcell.NewEventKey(tcell.KeyRune, 'T', tcell.ModNone),
 			Description: "Tags",
@@ -0,0 +1,216 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	ssmTypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/bporter816/aws-tui/internal/model"
+	"github.com/bporter816/aws-tui/internal/repo"
+	"github.com/bporter816/aws-tui/internal/utils"
+	"github.com/bporter816/aws-tui/internal/view"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const ssmDefaultKMSKey = "alias/aws/ssm"
+
+var ssmParameterTypes = []ssmTypes.ParameterType{
+	ssmTypes.ParameterTypeString,
+	ssmTypes.ParameterTypeStringList,
+	ssmTypes.ParameterTypeSecureString,
+}
+
+var ssmParameterTiers = []ssmTypes.ParameterTier{
+	ssmTypes.ParameterTierStandard,
+	ssmTypes.ParameterTierAdvanced,
+	ssmTypes.ParameterTierIntelligentTiering,
+}
+
+type SSMPutParameterForm struct {
+	*tview.Form
+	view.SSM
+	repo       *repo.SSM
+	kmsRepo    *repo.KMS
+	name       string
+	overwrite  bool
+	secure     bool
+	kmsKeys    []string
+	app        *Application
+	onComplete func()
+}
+
+// NewSSMPutParameterForm creates a parameter under the given path, or overwrites the named parameter
+// if its existing metadata and value are given
+func NewSSMPutParameterForm(repo *repo.SSM, kmsRepo *repo.KMS, name string, existing *model.SSMParameter, value string, app *Application, onComplete func()) *SSMPutParameterForm {
+	title := " Put Parameter "
+	if existing != nil {
+		title = " Overwrite " + name + " "
+	}
+	form := tview.NewForm()
+	form.SetBorder(true)
+	form.SetTitle(title)
+	form.SetTitleColor(tcell.ColorGreen)
+
+	s := &SSMPutParameterForm{
+		Form:       form,
+		repo:       repo,
+		kmsRepo:    kmsRepo,
+		name:       name,
+		overwrite:  existing != nil,
+		kmsKeys:    []string{ssmDefaultKMSKey},
+		app:        app,
+		onComplete: onComplete,
+	}
+
+	var types, tiers []string
+	currentType, currentTier := 0, 0
+	for i, v := range ssmParameterTypes {
+		types = append(types, string(v))
+		if existing != nil && existing.Type == v {
+			currentType = i
+		}
+	}
+	for i, v := range ssmParameterTiers {
+		tiers = append(tiers, string(v))
+		if existing != nil && existing.Tier == v {
+			currentTier = i
+		}
+	}
+	var description string
+	if existing != nil {
+		description = utils.DerefString(existing.Description, "")
+	}
+
+	form.AddInputField("Name", name, 0, nil, nil)
+	form.AddInputField("Description", description, 0, nil, nil)
+	form.AddDropDown("Type", types, currentType, nil)
+	form.AddDropDown("Tier", tiers, currentTier, nil)
+	form.AddDropDown("KMS Key", s.kmsKeys, 0, nil)
+	s.setValueField(value, ssmParameterTypes[currentType] == ssmTypes.ParameterTypeSecureString)
+	form.GetFormItem(2).(*tview.DropDown).SetSelectedFunc(func(option string, index int) {
+		s.setValueField(s.getValue(), ssmParameterTypes[index] == ssmTypes.ParameterTypeSecureString)
+	})
+
+	form.AddButton("Save", s.saveHandler)
+	form.AddButton("Cancel", s.cancelHandler)
+
+	if existing != nil {
+		form.GetFormItem(0).(*tview.InputField).SetDisabled(true)
+	}
+
+	form.SetFieldBackgroundColor(tcell.ColorBlack)
+	form.SetFieldTextColor(tcell.ColorWhite)
+	form.SetLabelColor(tcell.ColorYellow)
+	form.SetButtonBackgroundColor(tcell.ColorGreen)
+	form.SetButtonTextColor(tcell.ColorBlack)
+
+	var keyId string
+	if existing != nil {
+		keyId = utils.DerefString(existing.KeyId, "")
+	}
+	s.setKMSKeys(keyId)
+	return s
+}
+
+// setKMSKeys lists the available key aliases, keeping the parameter's current key selected
+func (s *SSMPutParameterForm) setKMSKeys(current string) {
+	aliases, err := s.kmsRepo.ListAliases()
+	if err == nil {
+		for _, v := range aliases {
+			if v != ssmDefaultKMSKey && !strings.HasPrefix(v, "alias/aws/") {
+				s.kmsKeys = append(s.kmsKeys, v)
+			}
+		}
+	}
+	selected := 0
+	if current != "" {
+		selected = -1
+		for i, v := range s.kmsKeys {
+			if v == current {
+				selected = i
+			}
+		}
+		// the key may be stored as an id or arn rather than an alias
+		if selected < 0 {
+			s.kmsKeys = append(s.kmsKeys, current)
+			selected = len(s.kmsKeys) - 1
+		}
+	}
+	dropDown := s.GetFormItem(4).(*tview.DropDown)
+	dropDown.SetOptions(s.kmsKeys, nil)
+	dropDown.SetCurrentOption(selected)
+}
+
+// setValueField masks the value while the type is SecureString. The value is the last form item, so it can be
+// replaced when the type changes.
+func (s *SSMPutParameterForm) setValueField(value string, secure bool) {
+	if s.GetFormItemCount() > 5 {
+		if secure == s.secure {
+			return
+		}
+		s.RemoveFormItem(5)
+	}
+	s.secure = secure
+	if secure {
+		s.AddPasswordField("Value", value, 0, '*', nil)
+	} else {
+		s.AddTextArea("Value", value, 0, 8, 0, nil)
+	}
+}
+
+func (s *SSMPutParameterForm) getValue() string {
+	switch item := s.GetFormItem(5).(type) {
+	case *tview.TextArea:
+		return item.GetText()
+	case *tview.InputField:
+		return item.GetText()
+	}
+	return ""
+}
+
+func (s *SSMPutParameterForm) saveHandler() {
+	name := strings.TrimSpace(s.GetFormItem(0).(*tview.InputField).GetText())
+	description := strings.TrimSpace(s.GetFormItem(1).(*tview.InputField).GetText())
+	typeIndex, _ := s.GetFormItem(2).(*tview.DropDown).GetCurrentOption()
+	tierIndex, _ := s.GetFormItem(3).(*tview.DropDown).GetCurrentOption()
+	_, keyId := s.GetFormItem(4).(*tview.DropDown).GetCurrentOption()
+	value := s.getValue()
+
+	if len(name) == 0 || strings.HasSuffix(name, "/") {
+		s.app.ShowError(s.GetService(), "Parameter name is required")
+		return
+	}
+	if len(value) == 0 {
+		s.app.ShowError(s.GetService(), "Parameter value is required")
+		return
+	}
+
+	if _, err := s.repo.PutParameter(name, value, description, ssmParameterTypes[typeIndex], ssmParameterTiers[tierIndex], keyId, s.overwrite); err != nil {
+		s.app.ShowError(s.GetService(), fmt.Sprintf("Put parameter failed: %v", err))
+		return
+	}
+
+	s.app.Close()
+	if s.onComplete != nil {
+		s.onComplete()
+	}
+}
+
+func (s *SSMPutParameterForm) cancelHandler() {
+	s.app.Close()
+}
+
+func (s SSMPutParameterForm) GetLabels() []string {
+	if s.overwrite {
+		return []string{s.name, "Overwrite"}
+	}
+	return []string{"Put Parameter"}
+}
+
+func (s SSMPutParameterForm) GetKeyActions() []KeyAction {
+	return []KeyAction{}
+}
+
+func (s SSMPutParameterForm) Render() {
+}
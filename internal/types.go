@@ -11,3 +11,9 @@ type Component interface {
 	GetKeyActions() []KeyAction
 	Render()
 }
+
+// CloseGuard is implemented by components that need confirmation before the user navigates away from them, like
+// views holding unsubmitted changes. An empty warning means they can close.
+type CloseGuard interface {
+	CloseWarning() string
+}
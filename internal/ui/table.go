@@ -35,7 +35,10 @@ func NewTable(headers []string, fixedRows, fixedCols int) *Table {
 }
 
 func (t *Table) SetData(data [][]string) {
-	// TODO handle changing data size
+	// drop rows left over from a previous, longer data set
+	for r := t.GetRowCount() - 1; r > len(data); r-- {
+		t.RemoveRow(r)
+	}
 	for r, v := range data {
 		for c, vv := range v {
 			t.SetCell(r+1, c, tview.NewTableCell(vv))
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// GetDaysRemaining returns the number of whole days until t, which is negative once t has passed
+func GetDaysRemaining(t, now time.Time) int {
+	return int(math.Floor(t.Sub(now).Hours() / 24))
+}
+
+func parsePrivateKeyFromPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+	// ACM only accepts unencrypted keys
+	if block.Type == "ENCRYPTED PRIVATE KEY" || block.Headers["Proc-Type"] != "" {
+		return nil, errors.New("private key must not be encrypted")
+	}
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %v", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key type")
+	}
+	return signer, nil
+}
+
+// ValidateCertificateImport checks a certificate, its private key and optional chain before they are imported into
+// ACM. The certificate must be a single certificate whose public key matches the private key, and the chain must be
+// ordered from the certificate's issuer towards the root.
+func ValidateCertificateImport(certificate, privateKey, chain []byte) error {
+	certs, err := ParseCertsFromPEM(certificate)
+	if err != nil {
+		return fmt.Errorf("invalid certificate: %v", err)
+	}
+	if len(certs) != 1 {
+		return fmt.Errorf("certificate file must contain exactly one certificate, found %v", len(certs))
+	}
+
+	key, err := parsePrivateKeyFromPEM(privateKey)
+	if err != nil {
+		return err
+	}
+	publicKey, ok := certs[0].PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !publicKey.Equal(key.Public()) {
+		return errors.New("private key does not match the certificate")
+	}
+
+	if len(chain) == 0 {
+		return nil
+	}
+	chainCerts, err := ParseCertsFromPEM(chain)
+	if err != nil {
+		return fmt.Errorf("invalid certificate chain: %v", err)
+	}
+	if len(chainCerts) == 0 {
+		return errors.New("certificate chain does not contain any certificates")
+	}
+	all := append(certs, chainCerts...)
+	for i := 0; i < len(all)-1; i++ {
+		if err := all[i].CheckSignatureFrom(all[i+1]); err != nil {
+			if i == 0 {
+				return errors.New("the first certificate in the chain did not issue the certificate")
+			}
+			return fmt.Errorf("certificate %v in the chain did not issue certificate %v, the chain must be ordered from the issuer towards the root", i+1, i)
+		}
+	}
+	return nil
+}
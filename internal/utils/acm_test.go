@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestGetDaysRemaining(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input    time.Time
+		expected int
+	}{
+		{
+			input:    now.Add(30 * 24 * time.Hour),
+			expected: 30,
+		},
+		{
+			input:    now.Add(36 * time.Hour),
+			expected: 1,
+		},
+		{
+			input:    now.Add(time.Hour),
+			expected: 0,
+		},
+		{
+			input:    now.Add(-time.Hour),
+			expected: -1,
+		},
+	}
+
+	for _, tc := range tests {
+		got := GetDaysRemaining(tc.input, now)
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+// newTestCert creates a certificate signed by parent, or a self-signed one if parent is nil
+func newTestCert(t *testing.T, name string, serial int64, parent *testCert) testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil || name != "leaf",
+	}
+	issuer, issuerKey := template, key
+	if parent != nil {
+		issuer, issuerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testCert{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func encodeTestKey(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestValidateCertificateImport(t *testing.T) {
+	root := newTestCert(t, "root", 1, nil)
+	intermediate := newTestCert(t, "intermediate", 2, &root)
+	leaf := newTestCert(t, "leaf", 3, &intermediate)
+	other := newTestCert(t, "other", 4, nil)
+
+	tests := []struct {
+		certificate []byte
+		privateKey  []byte
+		chain       []byte
+		isValid     bool
+	}{
+		{
+			certificate: leaf.pem,
+			privateKey:  encodeTestKey(t, leaf.key),
+			chain:       append(append([]byte{}, intermediate.pem...), root.pem...),
+			isValid:     true,
+		},
+		{
+			certificate: leaf.pem,
+			privateKey:  encodeTestKey(t, leaf.key),
+			chain:       nil,
+			isValid:     true,
+		},
+		{
+			// chain in the wrong order
+			certificate: leaf.pem,
+			privateKey:  encodeTestKey(t, leaf.key),
+			chain:       append(append([]byte{}, root.pem...), intermediate.pem...),
+			isValid:     false,
+		},
+		{
+			// key for a different certificate
+			certificate: leaf.pem,
+			privateKey:  encodeTestKey(t, other.key),
+			chain:       intermediate.pem,
+			isValid:     false,
+		},
+		{
+			// full chain in the certificate file
+			certificate: append(append([]byte{}, leaf.pem...), intermediate.pem...),
+			privateKey:  encodeTestKey(t, leaf.key),
+			chain:       nil,
+			isValid:     false,
+		},
+		{
+			certificate: leaf.pem,
+			privateKey:  []byte("not a key"),
+			chain:       nil,
+			isValid:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		err := ValidateCertificateImport(tc.certificate, tc.privateKey, tc.chain)
+		if tc.isValid != (err == nil) {
+			t.Fatalf("expected valid: %v, got error: %v", tc.isValid, err)
+		}
+	}
+}
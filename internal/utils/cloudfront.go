@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// ParseCloudFrontInvalidationPaths gets the paths from text with one path per line, skipping blank lines and duplicates
+func ParseCloudFrontInvalidationPaths(text string) []string {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(text, "\n") {
+		path := strings.TrimSpace(line)
+		if len(path) == 0 || seen[path] {
+			continue
+		}
+		seen[path] = true
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// ValidateCloudFrontInvalidationPath checks that a path is absolute and only uses a wildcard as its last character
+func ValidateCloudFrontInvalidationPath(path string) error {
+	if !strings.HasPrefix(path, "/") {
+		return errors.New("path must start with /")
+	}
+	if i := strings.Index(path, "*"); i >= 0 && i != len(path)-1 {
+		return errors.New("wildcard must be the last character of the path")
+	}
+	if strings.IndexFunc(path, unicode.IsSpace) >= 0 {
+		return errors.New("path must not contain whitespace")
+	}
+	return nil
+}
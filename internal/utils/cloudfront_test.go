@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCloudFrontInvalidationPaths(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{
+			input:    "",
+			expected: nil,
+		},
+		{
+			input:    "/index.html\n\n  /images/*  \n/index.html\n",
+			expected: []string{"/index.html", "/images/*"},
+		},
+	}
+
+	for _, tc := range tests {
+		got := ParseCloudFrontInvalidationPaths(tc.input)
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestValidateCloudFrontInvalidationPath(t *testing.T) {
+	tests := []struct {
+		input   string
+		isValid bool
+	}{
+		{
+			input:   "/*",
+			isValid: true,
+		},
+		{
+			input:   "/images/logo.png",
+			isValid: true,
+		},
+		{
+			input:   "/images/logo*",
+			isValid: true,
+		},
+		{
+			input:   "images/*",
+			isValid: false,
+		},
+		{
+			input:   "/images/*/logo.png",
+			isValid: false,
+		},
+		{
+			input:   "/**",
+			isValid: false,
+		},
+		{
+			input:   "/my file.html",
+			isValid: false,
+		},
+	}
+
+	for _, tc := range tests {
+		got := ValidateCloudFrontInvalidationPath(tc.input) == nil
+		if got != tc.isValid {
+			t.Fatalf("expected: %v, got: %v", tc.isValid, got)
+		}
+	}
+}
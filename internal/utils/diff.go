@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+const diffContextLines = 3
+
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// diffLines gets the line edit script from a to b using their longest common subsequence
+func diffLines(a []string, b []string) []diffLine {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if a[i] == b[j] {
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		} else if lcs[i+1][j] >= lcs[i][j+1] {
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		} else {
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{'+', b[j]})
+	}
+	return lines
+}
+
+func splitDiffLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// UnifiedDiff formats the changes from a to b as a unified diff, or returns an empty string if they are the same
+func UnifiedDiff(a string, b string, aName string, bName string) string {
+	lines := diffLines(splitDiffLines(a), splitDiffLines(b))
+
+	var changes []int
+	for i, v := range lines {
+		if v.op != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("--- " + aName + "\n")
+	sb.WriteString("+++ " + bName + "\n")
+	for k := 0; k < len(changes); {
+		// merge changes whose context would overlap into one hunk
+		last := k
+		for last+1 < len(changes) && changes[last+1]-changes[last] <= 2*diffContextLines {
+			last++
+		}
+		start := max(changes[k]-diffContextLines, 0)
+		end := min(changes[last]+diffContextLines+1, len(lines))
+
+		var aStart, bStart, aCount, bCount int
+		for _, v := range lines[:start] {
+			if v.op != '+' {
+				aStart++
+			}
+			if v.op != '-' {
+				bStart++
+			}
+		}
+		for _, v := range lines[start:end] {
+			if v.op != '+' {
+				aCount++
+			}
+			if v.op != '-' {
+				bCount++
+			}
+		}
+		// line numbers are 1-based, except that an empty range points at the line before it
+		if aCount > 0 {
+			aStart++
+		}
+		if bCount > 0 {
+			bStart++
+		}
+		sb.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", aStart, aCount, bStart, bCount))
+		for _, v := range lines[start:end] {
+			sb.WriteString(string(v.op) + v.text + "\n")
+		}
+		k = last + 1
+	}
+	return sb.String()
+}
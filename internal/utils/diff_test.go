@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected string
+	}{
+		{
+			a:        "same\n",
+			b:        "same\n",
+			expected: "",
+		},
+		{
+			a:        "",
+			b:        "one\ntwo\n",
+			expected: "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+one\n+two\n",
+		},
+		{
+			a:        "1\n2\n3\n4\n5\n",
+			b:        "1\n2\nthree\n4\n5\n",
+			expected: "--- a\n+++ b\n@@ -1,5 +1,5 @@\n 1\n 2\n-3\n+three\n 4\n 5\n",
+		},
+		{
+			a:        "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			b:        "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n",
+			expected: "--- a\n+++ b\n@@ -1,3 +1,4 @@\n+0\n 1\n 2\n 3\n@@ -9,4 +10,3 @@\n 9\n 10\n 11\n-12\n",
+		},
+	}
+
+	for _, tc := range tests {
+		got := UnifiedDiff(tc.a, tc.b, "a", "b")
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
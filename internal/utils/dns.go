@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"net"
+	"sort"
+	"strings"
+)
+
+// parseDNSCharacterStrings joins the quoted character strings of a TXT record, which resolvers return already joined
+func parseDNSCharacterStrings(value string) string {
+	if !strings.HasPrefix(value, "\"") {
+		return value
+	}
+	var sb strings.Builder
+	inQuotes, escaped := false, false
+	for _, c := range value {
+		switch {
+		case escaped:
+			sb.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+func normalizeDNSName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + "."
+}
+
+// NormalizeDNSAnswer converts record data to a canonical form, so that answers from different sources can be compared
+func NormalizeDNSAnswer(recordType, value string) string {
+	value = strings.TrimSpace(value)
+	fields := strings.Fields(value)
+	switch recordType {
+	case "A", "AAAA":
+		if ip := net.ParseIP(value); ip != nil {
+			return ip.String()
+		}
+	case "CNAME", "NS", "PTR":
+		return normalizeDNSName(value)
+	case "MX":
+		if len(fields) == 2 {
+			return fields[0] + " " + normalizeDNSName(fields[1])
+		}
+	case "SRV":
+		if len(fields) == 4 {
+			return strings.Join(fields[:3], " ") + " " + normalizeDNSName(fields[3])
+		}
+	case "TXT", "SPF":
+		return parseDNSCharacterStrings(value)
+	}
+	return value
+}
+
+// CompareDNSAnswers returns the values only found in the first answer and the values only found in the second
+func CompareDNSAnswers(recordType string, a, b []string) ([]string, []string) {
+	normalize := func(values []string) map[string]bool {
+		ret := make(map[string]bool)
+		for _, v := range values {
+			ret[NormalizeDNSAnswer(recordType, v)] = true
+		}
+		return ret
+	}
+	aValues, bValues := normalize(a), normalize(b)
+
+	var onlyA, onlyB []string
+	for v := range aValues {
+		if !bValues[v] {
+			onlyA = append(onlyA, v)
+		}
+	}
+	for v := range bValues {
+		if !aValues[v] {
+			onlyB = append(onlyB, v)
+		}
+	}
+	sort.Strings(onlyA)
+	sort.Strings(onlyB)
+	return onlyA, onlyB
+}
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeDNSAnswer(t *testing.T) {
+	tests := []struct {
+		recordType string
+		input      string
+		expected   string
+	}{
+		{
+			recordType: "A",
+			input:      "192.0.2.1",
+			expected:   "192.0.2.1",
+		},
+		{
+			recordType: "AAAA",
+			input:      "2001:0db8:0000:0000:0000:0000:0000:0001",
+			expected:   "2001:db8::1",
+		},
+		{
+			recordType: "CNAME",
+			input:      "Target.Example.com",
+			expected:   "target.example.com.",
+		},
+		{
+			recordType: "MX",
+			input:      "10 Mail.example.com.",
+			expected:   "10 mail.example.com.",
+		},
+		{
+			recordType: "SRV",
+			input:      "1 10 5269 xmpp.example.com",
+			expected:   "1 10 5269 xmpp.example.com.",
+		},
+		{
+			recordType: "TXT",
+			input:      "\"v=spf1 \" \"include:\\\"example.com\\\" -all\"",
+			expected:   "v=spf1 include:\"example.com\" -all",
+		},
+		{
+			recordType: "TXT",
+			input:      "unquoted",
+			expected:   "unquoted",
+		},
+	}
+
+	for _, tc := range tests {
+		got := NormalizeDNSAnswer(tc.recordType, tc.input)
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestCompareDNSAnswers(t *testing.T) {
+	tests := []struct {
+		recordType string
+		a          []string
+		b          []string
+		onlyA      []string
+		onlyB      []string
+	}{
+		{
+			recordType: "A",
+			a:          []string{"192.0.2.1", "192.0.2.2"},
+			b:          []string{"192.0.2.2", "192.0.2.1"},
+			onlyA:      nil,
+			onlyB:      nil,
+		},
+		{
+			recordType: "MX",
+			a:          []string{"10 mail.example.com.", "20 mail2.example.com."},
+			b:          []string{"10 mail.example.com", "30 mail3.example.com."},
+			onlyA:      []string{"20 mail2.example.com."},
+			onlyB:      []string{"30 mail3.example.com."},
+		},
+		{
+			recordType: "TXT",
+			a:          []string{"\"hello\""},
+			b:          nil,
+			onlyA:      []string{"hello"},
+			onlyB:      nil,
+		},
+	}
+
+	for _, tc := range tests {
+		onlyA, onlyB := CompareDNSAnswers(tc.recordType, tc.a, tc.b)
+		if !reflect.DeepEqual(onlyA, tc.onlyA) {
+			t.Fatalf("expected: %v, got: %v", tc.onlyA, onlyA)
+		}
+		if !reflect.DeepEqual(onlyB, tc.onlyB) {
+			t.Fatalf("expected: %v, got: %v", tc.onlyB, onlyB)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"errors"
+	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+// GetECSAwsLogsLocation resolves the CloudWatch log group, stream and region for a container
+// that uses the awslogs driver. Streams are named prefix/container-name/task-id.
+func GetECSAwsLogsLocation(def ecsTypes.ContainerDefinition, taskId string) (string, string, string, error) {
+	if def.LogConfiguration == nil || def.LogConfiguration.LogDriver != ecsTypes.LogDriverAwslogs {
+		return "", "", "", errors.New("container does not use the awslogs log driver")
+	}
+	options := def.LogConfiguration.Options
+	group, ok := options["awslogs-group"]
+	if !ok {
+		return "", "", "", errors.New("awslogs-group is not set")
+	}
+	prefix, ok := options["awslogs-stream-prefix"]
+	if !ok {
+		return "", "", "", errors.New("awslogs-stream-prefix is not set, so the log stream name cannot be determined")
+	}
+	if def.Name == nil {
+		return "", "", "", errors.New("container name is not set")
+	}
+	return group, prefix + "/" + *def.Name + "/" + taskId, options["awslogs-region"], nil
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"testing"
+)
+
+func TestGetECSAwsLogsLocation(t *testing.T) {
+	tests := []struct {
+		input          ecsTypes.ContainerDefinition
+		expectedGroup  string
+		expectedStream string
+		expectedRegion string
+		expectedErr    bool
+	}{
+		{
+			input: ecsTypes.ContainerDefinition{
+				Name: aws.String("web"),
+				LogConfiguration: &ecsTypes.LogConfiguration{
+					LogDriver: ecsTypes.LogDriverAwslogs,
+					Options: map[string]string{
+						"awslogs-group":         "/ecs/web",
+						"awslogs-stream-prefix": "ecs",
+						"awslogs-region":        "us-east-1",
+					},
+				},
+			},
+			expectedGroup:  "/ecs/web",
+			expectedStream: "ecs/web/abc123",
+			expectedRegion: "us-east-1",
+		},
+		{
+			input: ecsTypes.ContainerDefinition{
+				Name: aws.String("web"),
+				LogConfiguration: &ecsTypes.LogConfiguration{
+					LogDriver: ecsTypes.LogDriverAwslogs,
+					Options: map[string]string{
+						"awslogs-group": "/ecs/web",
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			input: ecsTypes.ContainerDefinition{
+				Name: aws.String("web"),
+				LogConfiguration: &ecsTypes.LogConfiguration{
+					LogDriver: ecsTypes.LogDriverFluentd,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			input: ecsTypes.ContainerDefinition{
+				Name: aws.String("web"),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		group, stream, region, err := GetECSAwsLogsLocation(tc.input, "abc123")
+		if tc.expectedErr {
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if group != tc.expectedGroup || stream != tc.expectedStream || region != tc.expectedRegion {
+			t.Fatalf("expected: %v %v %v, got: %v %v %v", tc.expectedGroup, tc.expectedStream, tc.expectedRegion, group, stream, region)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"gopkg.in/yaml.v3"
+	"regexp"
+	"strconv"
+)
+
+var versionNumberRe = regexp.MustCompile("[0-9]+")
+
+// compareVersions compares the numeric components of two version strings like v1.15.1-eksbuild.1
+func compareVersions(a, b string) int {
+	aParts := versionNumberRe.FindAllString(a, -1)
+	bParts := versionNumberRe.FindAllString(b, -1)
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		x, _ := strconv.Atoi(aParts[i])
+		y, _ := strconv.Atoi(bParts[i])
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(aParts) - len(bParts)
+}
+
+func LatestEKSAddonVersion(versions []string) string {
+	var latest string
+	for _, v := range versions {
+		if len(latest) == 0 || compareVersions(v, latest) > 0 {
+			latest = v
+		}
+	}
+	return latest
+}
+
+type EKSKubeconfigEntry struct {
+	ClusterArn               string
+	ClusterName              string
+	Endpoint                 string
+	CertificateAuthorityData string
+	Region                   string
+}
+
+// mappingValue returns the value node for a key in a yaml mapping, or nil if the key isn't set
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue replaces the value for a key in a yaml mapping in place, or appends the key
+func setMappingValue(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+func encodeNode(v any) (*yaml.Node, error) {
+	node := &yaml.Node{}
+	if err := node.Encode(v); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// upsertNamedEntry replaces the entry with the given name in a kubeconfig list, or appends it
+func upsertNamedEntry(config *yaml.Node, key string, name string, value any) error {
+	valueNode, err := encodeNode(value)
+	if err != nil {
+		return err
+	}
+	list := mappingValue(config, key)
+	if list == nil || list.Tag == "!!null" {
+		list = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		setMappingValue(config, key, list)
+	}
+	if list.Kind != yaml.SequenceNode {
+		return errors.New("kubeconfig field " + key + " is not a list")
+	}
+	for _, v := range list.Content {
+		if v.Kind != yaml.MappingNode {
+			continue
+		}
+		if n := mappingValue(v, "name"); n != nil && n.Value == name {
+			setMappingValue(v, key[:len(key)-1], valueNode)
+			return nil
+		}
+	}
+	entry := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	setMappingValue(entry, "name", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name})
+	setMappingValue(entry, key[:len(key)-1], valueNode)
+	list.Content = append(list.Content, entry)
+	return nil
+}
+
+// MergeEKSKubeconfig adds or replaces the cluster, user and context for an EKS cluster in a kubeconfig,
+// and makes it the current context. This matches the output of aws eks update-kubeconfig, where
+// everything is named after the cluster arn and credentials come from aws eks get-token.
+// The rest of the file is edited in place, so comments and key order are kept.
+func MergeEKSKubeconfig(existing []byte, entry EKSKubeconfigEntry) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(existing, &doc); err != nil {
+		return []byte{}, err
+	}
+	if doc.Kind == 0 || len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	config := doc.Content[0]
+	if config.Tag == "!!null" {
+		*config = yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	}
+	if config.Kind != yaml.MappingNode {
+		return []byte{}, errors.New("kubeconfig is not a mapping")
+	}
+	defaults := []struct {
+		key   string
+		value any
+	}{
+		{"apiVersion", "v1"},
+		{"kind", "Config"},
+		{"preferences", map[string]any{}},
+	}
+	for _, v := range defaults {
+		if mappingValue(config, v.key) == nil {
+			node, err := encodeNode(v.value)
+			if err != nil {
+				return []byte{}, err
+			}
+			setMappingValue(config, v.key, node)
+		}
+	}
+
+	cluster := map[string]any{
+		"server":                     entry.Endpoint,
+		"certificate-authority-data": entry.CertificateAuthorityData,
+	}
+	user := map[string]any{
+		"exec": map[string]any{
+			"apiVersion": "client.authentication.k8s.io/v1beta1",
+			"command":    "aws",
+			"args": []any{
+				"--region",
+				entry.Region,
+				"eks",
+				"get-token",
+				"--cluster-name",
+				entry.ClusterName,
+				"--output",
+				"json",
+			},
+		},
+	}
+	context := map[string]any{
+		"cluster": entry.ClusterArn,
+		"user":    entry.ClusterArn,
+	}
+	if err := upsertNamedEntry(config, "clusters", entry.ClusterArn, cluster); err != nil {
+		return []byte{}, err
+	}
+	if err := upsertNamedEntry(config, "users", entry.ClusterArn, user); err != nil {
+		return []byte{}, err
+	}
+	if err := upsertNamedEntry(config, "contexts", entry.ClusterArn, context); err != nil {
+		return []byte{}, err
+	}
+	setMappingValue(config, "current-context", &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: entry.ClusterArn})
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return []byte{}, err
+	}
+	if err := enc.Close(); err != nil {
+		return []byte{}, err
+	}
+	return buf.Bytes(), nil
+}
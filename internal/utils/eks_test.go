@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"gopkg.in/yaml.v3"
+	"strings"
+	"testing"
+)
+
+func TestLatestEKSAddonVersion(t *testing.T) {
+	tests := []struct {
+		input    []string
+		expected string
+	}{
+		{
+			input:    []string{"v1.15.1-eksbuild.1", "v1.16.0-eksbuild.1", "v1.15.10-eksbuild.2"},
+			expected: "v1.16.0-eksbuild.1",
+		},
+		{
+			input:    []string{"v1.10.1-eksbuild.1", "v1.10.1-eksbuild.12", "v1.10.1-eksbuild.2"},
+			expected: "v1.10.1-eksbuild.12",
+		},
+		{
+			input:    []string{},
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		got := LatestEKSAddonVersion(tc.input)
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestMergeEKSKubeconfig(t *testing.T) {
+	entry := EKSKubeconfigEntry{
+		ClusterArn:               "arn:aws:eks:us-east-1:123456789012:cluster/prod",
+		ClusterName:              "prod",
+		Endpoint:                 "https://example.eks.amazonaws.com",
+		CertificateAuthorityData: "Y2VydA==",
+		Region:                   "us-east-1",
+	}
+	existing := []byte(`apiVersion: v1
+kind: Config
+# clusters managed by hand
+clusters:
+- name: other
+  cluster:
+    server: https://other.example.com
+- name: arn:aws:eks:us-east-1:123456789012:cluster/prod
+  cluster:
+    server: https://stale.example.com
+contexts:
+- name: other
+  context:
+    cluster: other
+    user: other
+users:
+- name: other
+  user:
+    token: abc
+current-context: other
+`)
+
+	tests := []struct {
+		input            []byte
+		expectedClusters int
+		expectedContexts int
+		expectedUsers    int
+		expectedPrefix   string
+	}{
+		{
+			input:            []byte{},
+			expectedClusters: 1,
+			expectedContexts: 1,
+			expectedUsers:    1,
+		},
+		{
+			input:            existing,
+			expectedClusters: 2,
+			expectedContexts: 2,
+			expectedUsers:    2,
+			expectedPrefix:   "apiVersion: v1\nkind: Config\n# clusters managed by hand\nclusters:\n",
+		},
+	}
+
+	for _, tc := range tests {
+		out, err := MergeEKSKubeconfig(tc.input, entry)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.HasPrefix(string(out), tc.expectedPrefix) {
+			t.Fatalf("expected prefix: %v, got: %v", tc.expectedPrefix, string(out))
+		}
+		var config struct {
+			CurrentContext string `yaml:"current-context"`
+			Clusters       []struct {
+				Name    string `yaml:"name"`
+				Cluster struct {
+					Server string `yaml:"server"`
+				} `yaml:"cluster"`
+			} `yaml:"clusters"`
+			Contexts []any `yaml:"contexts"`
+			Users    []any `yaml:"users"`
+		}
+		if err := yaml.Unmarshal(out, &config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if config.CurrentContext != entry.ClusterArn {
+			t.Fatalf("expected current context: %v, got: %v", entry.ClusterArn, config.CurrentContext)
+		}
+		if len(config.Clusters) != tc.expectedClusters || len(config.Contexts) != tc.expectedContexts || len(config.Users) != tc.expectedUsers {
+			t.Fatalf("expected: %v/%v/%v entries, got: %v/%v/%v", tc.expectedClusters, tc.expectedContexts, tc.expectedUsers, len(config.Clusters), len(config.Contexts), len(config.Users))
+		}
+		for _, v := range config.Clusters {
+			if v.Name == entry.ClusterArn && v.Cluster.Server != entry.Endpoint {
+				t.Fatalf("expected server: %v, got: %v", entry.Endpoint, v.Cluster.Server)
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	elbTypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GetELBTargetGroupName gets the name from a target group arn, which has a resource of targetgroup/name/id
+func GetELBTargetGroupName(targetGroupArn string) string {
+	a, err := arn.Parse(targetGroupArn)
+	if err != nil {
+		return targetGroupArn
+	}
+	parts := strings.Split(a.Resource, "/")
+	if len(parts) != 3 {
+		return targetGroupArn
+	}
+	return parts[1]
+}
+
+func formatELBRuleCondition(condition elbTypes.RuleCondition) string {
+	field := DerefString(condition.Field, "")
+	values := condition.Values
+	switch field {
+	case "host-header":
+		if condition.HostHeaderConfig != nil {
+			values = condition.HostHeaderConfig.Values
+		}
+		return "Host: " + strings.Join(values, ", ")
+	case "path-pattern":
+		if condition.PathPatternConfig != nil {
+			values = condition.PathPatternConfig.Values
+		}
+		return "Path: " + strings.Join(values, ", ")
+	case "http-header":
+		if condition.HttpHeaderConfig == nil {
+			return "Header"
+		}
+		return "Header " + DerefString(condition.HttpHeaderConfig.HttpHeaderName, "") + ": " + strings.Join(condition.HttpHeaderConfig.Values, ", ")
+	case "http-request-method":
+		if condition.HttpRequestMethodConfig != nil {
+			values = condition.HttpRequestMethodConfig.Values
+		}
+		return "Method: " + strings.Join(values, ", ")
+	case "query-string":
+		if condition.QueryStringConfig != nil {
+			values = []string{}
+			for _, v := range condition.QueryStringConfig.Values {
+				if v.Key != nil {
+					values = append(values, *v.Key+"="+DerefString(v.Value, ""))
+				} else {
+					values = append(values, DerefString(v.Value, ""))
+				}
+			}
+		}
+		return "Query: " + strings.Join(values, ", ")
+	case "source-ip":
+		if condition.SourceIpConfig != nil {
+			values = condition.SourceIpConfig.Values
+		}
+		return "Source IP: " + strings.Join(values, ", ")
+	default:
+		return field + ": " + strings.Join(values, ", ")
+	}
+}
+
+// FormatELBRuleConditions summarizes the conditions of a listener rule, all of which must match
+func FormatELBRuleConditions(conditions []elbTypes.RuleCondition) string {
+	var parts []string
+	for _, v := range conditions {
+		parts = append(parts, formatELBRuleCondition(v))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func formatELBRuleAction(action elbTypes.Action) string {
+	switch action.Type {
+	case elbTypes.ActionTypeEnumForward:
+		if action.ForwardConfig == nil || len(action.ForwardConfig.TargetGroups) == 0 {
+			return "Forward: " + GetELBTargetGroupName(DerefString(action.TargetGroupArn, ""))
+		}
+		if len(action.ForwardConfig.TargetGroups) == 1 {
+			return "Forward: " + GetELBTargetGroupName(DerefString(action.ForwardConfig.TargetGroups[0].TargetGroupArn, ""))
+		}
+		var groups []string
+		for _, v := range action.ForwardConfig.TargetGroups {
+			weight := 1
+			if v.Weight != nil {
+				weight = int(*v.Weight)
+			}
+			groups = append(groups, GetELBTargetGroupName(DerefString(v.TargetGroupArn, ""))+" ("+strconv.Itoa(weight)+")")
+		}
+		return "Forward: " + strings.Join(groups, ", ")
+	case elbTypes.ActionTypeEnumRedirect:
+		if action.RedirectConfig == nil {
+			return "Redirect"
+		}
+		c := action.RedirectConfig
+		location := DerefString(c.Protocol, "#{protocol}") + "://" + DerefString(c.Host, "#{host}") + ":" + DerefString(c.Port, "#{port}") + DerefString(c.Path, "/#{path}")
+		if query := DerefString(c.Query, "#{query}"); query != "" {
+			location += "?" + query
+		}
+		return "Redirect " + strings.TrimPrefix(string(c.StatusCode), "HTTP_") + ": " + location
+	case elbTypes.ActionTypeEnumFixedResponse:
+		if action.FixedResponseConfig == nil {
+			return "Fixed Response"
+		}
+		c := action.FixedResponseConfig
+		s := "Fixed Response " + DerefString(c.StatusCode, "")
+		if c.ContentType != nil {
+			s += " (" + *c.ContentType + ")"
+		}
+		return s
+	case elbTypes.ActionTypeEnumAuthenticateOidc:
+		return "Authenticate OIDC"
+	case elbTypes.ActionTypeEnumAuthenticateCognito:
+		return "Authenticate Cognito"
+	default:
+		return string(action.Type)
+	}
+}
+
+// FormatELBRuleActions summarizes the actions of a listener rule in the order they are performed
+func FormatELBRuleActions(actions []elbTypes.Action) string {
+	sorted := make([]elbTypes.Action, len(actions))
+	copy(sorted, actions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Order == nil || sorted[j].Order == nil {
+			return sorted[i].Order != nil
+		}
+		return *sorted[i].Order < *sorted[j].Order
+	})
+	var parts []string
+	for _, v := range sorted {
+		parts = append(parts, formatELBRuleAction(v))
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,176 @@
+package utils
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbTypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"testing"
+)
+
+func TestGetELBTargetGroupName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-targets/73e2d6bc24d8a067",
+			expected: "my-targets",
+		},
+		{
+			input:    "not-an-arn",
+			expected: "not-an-arn",
+		},
+	}
+
+	for _, tc := range tests {
+		got := GetELBTargetGroupName(tc.input)
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestFormatELBRuleConditions(t *testing.T) {
+	tests := []struct {
+		input    []elbTypes.RuleCondition
+		expected string
+	}{
+		{
+			input:    []elbTypes.RuleCondition{},
+			expected: "",
+		},
+		{
+			input: []elbTypes.RuleCondition{
+				{
+					Field:            aws.String("host-header"),
+					HostHeaderConfig: &elbTypes.HostHeaderConditionConfig{Values: []string{"example.com", "*.example.com"}},
+				},
+				{
+					Field:             aws.String("path-pattern"),
+					PathPatternConfig: &elbTypes.PathPatternConditionConfig{Values: []string{"/api/*"}},
+				},
+			},
+			expected: "Host: example.com, *.example.com; Path: /api/*",
+		},
+		{
+			input: []elbTypes.RuleCondition{
+				{
+					Field: aws.String("http-header"),
+					HttpHeaderConfig: &elbTypes.HttpHeaderConditionConfig{
+						HttpHeaderName: aws.String("X-Env"),
+						Values:         []string{"staging"},
+					},
+				},
+				{
+					Field:          aws.String("source-ip"),
+					SourceIpConfig: &elbTypes.SourceIpConditionConfig{Values: []string{"10.0.0.0/8"}},
+				},
+			},
+			expected: "Header X-Env: staging; Source IP: 10.0.0.0/8",
+		},
+		{
+			input: []elbTypes.RuleCondition{
+				{
+					Field: aws.String("query-string"),
+					QueryStringConfig: &elbTypes.QueryStringConditionConfig{
+						Values: []elbTypes.QueryStringKeyValuePair{
+							{Key: aws.String("version"), Value: aws.String("2")},
+							{Value: aws.String("debug")},
+						},
+					},
+				},
+			},
+			expected: "Query: version=2, debug",
+		},
+	}
+
+	for _, tc := range tests {
+		got := FormatELBRuleConditions(tc.input)
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestFormatELBRuleActions(t *testing.T) {
+	blueArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/blue/73e2d6bc24d8a067"
+	greenArn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/green/83e2d6bc24d8a067"
+	tests := []struct {
+		input    []elbTypes.Action
+		expected string
+	}{
+		{
+			input: []elbTypes.Action{
+				{
+					Type:           elbTypes.ActionTypeEnumForward,
+					TargetGroupArn: aws.String(blueArn),
+				},
+			},
+			expected: "Forward: blue",
+		},
+		{
+			input: []elbTypes.Action{
+				{
+					Type: elbTypes.ActionTypeEnumForward,
+					ForwardConfig: &elbTypes.ForwardActionConfig{
+						TargetGroups: []elbTypes.TargetGroupTuple{
+							{TargetGroupArn: aws.String(blueArn), Weight: aws.Int32(80)},
+							{TargetGroupArn: aws.String(greenArn), Weight: aws.Int32(20)},
+						},
+					},
+				},
+			},
+			expected: "Forward: blue (80), green (20)",
+		},
+		{
+			input: []elbTypes.Action{
+				{
+					Type: elbTypes.ActionTypeEnumRedirect,
+					RedirectConfig: &elbTypes.RedirectActionConfig{
+						StatusCode: elbTypes.RedirectActionStatusCodeEnumHttp301,
+						Protocol:   aws.String("HTTPS"),
+						Port:       aws.String("443"),
+						Host:       aws.String("#{host}"),
+						Path:       aws.String("/#{path}"),
+						Query:      aws.String("#{query}"),
+					},
+				},
+			},
+			expected: "Redirect 301: HTTPS://#{host}:443/#{path}?#{query}",
+		},
+		{
+			input: []elbTypes.Action{
+				{
+					Type:  elbTypes.ActionTypeEnumForward,
+					Order: aws.Int32(2),
+					ForwardConfig: &elbTypes.ForwardActionConfig{
+						TargetGroups: []elbTypes.TargetGroupTuple{{TargetGroupArn: aws.String(greenArn)}},
+					},
+				},
+				{
+					Type:  elbTypes.ActionTypeEnumAuthenticateOidc,
+					Order: aws.Int32(1),
+				},
+			},
+			expected: "Authenticate OIDC; Forward: green",
+		},
+		{
+			input: []elbTypes.Action{
+				{
+					Type: elbTypes.ActionTypeEnumFixedResponse,
+					FixedResponseConfig: &elbTypes.FixedResponseActionConfig{
+						StatusCode:  aws.String("503"),
+						ContentType: aws.String("text/plain"),
+					},
+				},
+			},
+			expected: "Fixed Response 503 (text/plain)",
+		},
+	}
+
+	for _, tc := range tests {
+		got := FormatELBRuleActions(tc.input)
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
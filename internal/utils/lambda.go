@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"strings"
+)
+
+// ParseLambdaLayerArn returns the name and version of a layer version arn, which has the form
+// arn:aws:lambda:region:account:layer:name:version
+func ParseLambdaLayerArn(layerArn string) (string, string) {
+	a, err := arn.Parse(layerArn)
+	if err != nil {
+		return layerArn, ""
+	}
+	parts := strings.Split(a.Resource, ":")
+	if len(parts) != 3 || parts[0] != "layer" {
+		return a.Resource, ""
+	}
+	return parts[1], parts[2]
+}
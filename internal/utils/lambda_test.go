@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestParseLambdaLayerArn(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedName    string
+		expectedVersion string
+	}{
+		{
+			input:           "arn:aws:lambda:us-east-1:123456789012:layer:my-layer:3",
+			expectedName:    "my-layer",
+			expectedVersion: "3",
+		},
+		{
+			input:           "arn:aws:lambda:us-east-1:123456789012:layer:my-layer",
+			expectedName:    "layer:my-layer",
+			expectedVersion: "",
+		},
+		{
+			input:           "not-an-arn",
+			expectedName:    "not-an-arn",
+			expectedVersion: "",
+		},
+	}
+
+	for _, tc := range tests {
+		name, version := ParseLambdaLayerArn(tc.input)
+		if name != tc.expectedName || version != tc.expectedVersion {
+			t.Fatalf("expected: %v %v, got: %v %v", tc.expectedName, tc.expectedVersion, name, version)
+		}
+	}
+}
@@ -69,3 +69,41 @@ func JoinRoute53ResourceRecords(items []r53Types.ResourceRecord, sep string) str
 	}
 	return strings.TrimPrefix(ret, sep)
 }
+
+// Route 53 considers an endpoint healthy when more than this percentage of its health checkers do
+const route53HealthyCheckerPercent = 18
+
+func IsRoute53ObservationHealthy(v r53Types.HealthCheckObservation) bool {
+	return v.StatusReport != nil && v.StatusReport.Status != nil && strings.HasPrefix(*v.StatusReport.Status, "Success")
+}
+
+// GetRoute53HealthCheckHealth aggregates the health checker observations into the overall health of the endpoint,
+// along with how many checkers reported it healthy
+func GetRoute53HealthCheckHealth(observations []r53Types.HealthCheckObservation) (string, int) {
+	if len(observations) == 0 {
+		return "Unknown", 0
+	}
+	healthy := 0
+	for _, v := range observations {
+		if IsRoute53ObservationHealthy(v) {
+			healthy++
+		}
+	}
+	if healthy*100 > route53HealthyCheckerPercent*len(observations) {
+		return "Healthy", healthy
+	}
+	return "Unhealthy", healthy
+}
+
+// FindRoute53HostedZone returns the index of the zone with the longest name that contains the record, or -1 if none do
+func FindRoute53HostedZone(recordName string, zoneNames []string) int {
+	name := strings.ToLower(strings.TrimSuffix(recordName, "."))
+	index, longest := -1, -1
+	for i, v := range zoneNames {
+		zone := strings.ToLower(strings.TrimSuffix(v, "."))
+		if (name == zone || strings.HasSuffix(name, "."+zone)) && len(zone) > longest {
+			index, longest = i, len(zone)
+		}
+	}
+	return index
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+func newTestObservations(statuses ...string) []r53Types.HealthCheckObservation {
+	var observations []r53Types.HealthCheckObservation
+	for _, v := range statuses {
+		observations = append(observations, r53Types.HealthCheckObservation{
+			StatusReport: &r53Types.StatusReport{Status: aws.String(v)},
+		})
+	}
+	return observations
+}
+
+func TestGetRoute53HealthCheckHealth(t *testing.T) {
+	tests := []struct {
+		input           []r53Types.HealthCheckObservation
+		expectedHealth  string
+		expectedHealthy int
+	}{
+		{
+			input:           nil,
+			expectedHealth:  "Unknown",
+			expectedHealthy: 0,
+		},
+		{
+			input:           newTestObservations("Success: HTTP Status Code 200, OK", "Success: HTTP Status Code 200, OK"),
+			expectedHealth:  "Healthy",
+			expectedHealthy: 2,
+		},
+		{
+			// 2 of 10 is more than 18%
+			input:           newTestObservations("Success: HTTP Status Code 200, OK", "Success: HTTP Status Code 200, OK", "Failure: Connection timed out", "Failure: Connection timed out", "Failure: Connection timed out", "Failure: Connection timed out", "Failure: Connection timed out", "Failure: Connection timed out", "Failure: Connection timed out", "Failure: Connection timed out"),
+			expectedHealth:  "Healthy",
+			expectedHealthy: 2,
+		},
+		{
+			input:           newTestObservations("Success: HTTP Status Code 200, OK", "Failure: Connection timed out", "Failure: Connection timed out", "Failure: Connection timed out", "Failure: Connection timed out", "Failure: Connection timed out"),
+			expectedHealth:  "Unhealthy",
+			expectedHealthy: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		health, healthy := GetRoute53HealthCheckHealth(tc.input)
+		if health != tc.expectedHealth || healthy != tc.expectedHealthy {
+			t.Fatalf("expected: %v %v, got: %v %v", tc.expectedHealth, tc.expectedHealthy, health, healthy)
+		}
+	}
+}
+
+func TestFindRoute53HostedZone(t *testing.T) {
+	zoneNames := []string{"example.com.", "sub.example.com.", "example.net."}
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{
+			input:    "_abc.www.example.com.",
+			expected: 0,
+		},
+		{
+			input:    "_abc.api.Sub.example.com.",
+			expected: 1,
+		},
+		{
+			input:    "example.net",
+			expected: 2,
+		},
+		{
+			input:    "_abc.notexample.com.",
+			expected: -1,
+		},
+	}
+
+	for _, tc := range tests {
+		got := FindRoute53HostedZone(tc.input, zoneNames)
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,415 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+const defaultRoute53ZoneFileTTL = 300
+
+type zoneFileLine struct {
+	number    int
+	text      string
+	continued bool // the line starts with whitespace, so it reuses the previous owner name
+}
+
+// UnescapeRoute53Name converts the octal escapes Route 53 uses in record names (like \052 for *) back to characters
+func UnescapeRoute53Name(name string) string {
+	var sb strings.Builder
+	for i := 0; i < len(name); i++ {
+		if name[i] == '\\' && i+4 <= len(name) {
+			if v, err := strconv.ParseUint(name[i+1:i+4], 8, 8); err == nil {
+				sb.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		sb.WriteByte(name[i])
+	}
+	return sb.String()
+}
+
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+func qualifyZoneFileName(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(name) + "." + origin
+}
+
+// formatRoute53ZoneFileRecordSet renders a record set as zone file lines. Alias and routing policy records can't be
+// expressed in a zone file, so they are written as annotated comments.
+func formatRoute53ZoneFileRecordSet(v r53Types.ResourceRecordSet) []string {
+	name := UnescapeRoute53Name(aws.ToString(v.Name))
+	var setIdentifier string
+	if v.SetIdentifier != nil {
+		setIdentifier = fmt.Sprintf(", set identifier %q", *v.SetIdentifier)
+	}
+	if v.AliasTarget != nil {
+		return []string{fmt.Sprintf(
+			"; ALIAS %v %v -> %v (hosted zone %v, evaluate target health %v%v)",
+			name,
+			v.Type,
+			aws.ToString(v.AliasTarget.DNSName),
+			aws.ToString(v.AliasTarget.HostedZoneId),
+			v.AliasTarget.EvaluateTargetHealth,
+			setIdentifier,
+		)}
+	}
+	var lines []string
+	prefix := ""
+	if v.SetIdentifier != nil {
+		lines = append(lines, fmt.Sprintf("; ROUTING POLICY %v %v (%v)", name, v.Type, strings.TrimPrefix(setIdentifier, ", ")))
+		prefix = "; "
+	}
+	for _, rr := range v.ResourceRecords {
+		lines = append(lines, fmt.Sprintf("%v%v\t%v\tIN\t%v\t%v", prefix, name, aws.ToInt64(v.TTL), v.Type, aws.ToString(rr.Value)))
+	}
+	return lines
+}
+
+// FormatRoute53ZoneFile renders record sets as a BIND zone file
+func FormatRoute53ZoneFile(zoneName string, records []r53Types.ResourceRecordSet) string {
+	var sb strings.Builder
+	sb.WriteString("$ORIGIN " + fqdn(zoneName) + "\n")
+	for _, v := range records {
+		for _, line := range formatRoute53ZoneFileRecordSet(v) {
+			sb.WriteString(line + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// FormatRoute53Changes renders a change batch as a diff of zone file lines, where upserts are shown as additions
+func FormatRoute53Changes(changes []r53Types.Change) string {
+	var sb strings.Builder
+	for _, v := range changes {
+		if v.ResourceRecordSet == nil {
+			continue
+		}
+		prefix := "+"
+		if v.Action == r53Types.ChangeActionDelete {
+			prefix = "-"
+		}
+		for _, line := range formatRoute53ZoneFileRecordSet(*v.ResourceRecordSet) {
+			sb.WriteString(prefix + " " + line + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// CollapseRoute53Changes merges staged edits into a change batch Route 53 accepts. Each edit deletes the record it
+// started from, so a later edit of the same record, or of one created earlier in the batch, would delete a value that
+// no longer exists by the time it applies.
+func CollapseRoute53Changes(changes []r53Types.Change) []r53Types.Change {
+	var collapsed []r53Types.Change
+	find := func(action r53Types.ChangeAction, key string) int {
+		for i, v := range collapsed {
+			if v.Action == action && route53ChangeKey(*v.ResourceRecordSet) == key {
+				return i
+			}
+		}
+		return -1
+	}
+	for _, v := range changes {
+		if v.ResourceRecordSet == nil {
+			continue
+		}
+		key := route53ChangeKey(*v.ResourceRecordSet)
+		switch v.Action {
+		case r53Types.ChangeActionDelete:
+			created := find(r53Types.ChangeActionCreate, key)
+			if created >= 0 {
+				collapsed = append(collapsed[:created], collapsed[created+1:]...)
+			}
+			// the original record is already being deleted, or the record only exists in this batch
+			if created >= 0 || find(r53Types.ChangeActionDelete, key) >= 0 {
+				continue
+			}
+		case r53Types.ChangeActionCreate:
+			if i := find(r53Types.ChangeActionCreate, key); i >= 0 {
+				collapsed = append(collapsed[:i], collapsed[i+1:]...)
+			}
+		}
+		collapsed = append(collapsed, v)
+	}
+	return collapsed
+}
+
+// splitZoneFileLines strips comments and joins parenthesized records that span multiple lines
+func splitZoneFileLines(content string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var current *zoneFileLine
+	depth := 0
+	for i, raw := range strings.Split(content, "\n") {
+		var sb strings.Builder
+		inQuotes, escaped := false, false
+	scan:
+		for _, c := range raw {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inQuotes = !inQuotes
+			case inQuotes:
+			case c == ';':
+				break scan
+			case c == '(':
+				depth++
+				c = ' '
+			case c == ')':
+				depth--
+				if depth < 0 {
+					return nil, fmt.Errorf("line %v: unbalanced parentheses", i+1)
+				}
+				c = ' '
+			}
+			sb.WriteRune(c)
+		}
+		if current == nil {
+			current = &zoneFileLine{
+				number:    i + 1,
+				continued: len(raw) > 0 && (raw[0] == ' ' || raw[0] == '\t'),
+			}
+		}
+		current.text += " " + sb.String()
+		if depth == 0 {
+			current.text = strings.TrimSpace(current.text)
+			if current.text != "" {
+				lines = append(lines, *current)
+			}
+			current = nil
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %v: unbalanced parentheses", current.number)
+	}
+	return lines, nil
+}
+
+// splitZoneFileFields splits a line on whitespace, keeping quoted strings intact
+func splitZoneFileFields(text string) []string {
+	var fields []string
+	var sb strings.Builder
+	inQuotes, escaped := false, false
+	for _, c := range text {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+		case !inQuotes && (c == ' ' || c == '\t' || c == '\r'):
+			if sb.Len() > 0 {
+				fields = append(fields, sb.String())
+				sb.Reset()
+			}
+			continue
+		}
+		sb.WriteRune(c)
+	}
+	if sb.Len() > 0 {
+		fields = append(fields, sb.String())
+	}
+	return fields
+}
+
+// qualifyZoneFileData qualifies the domain names in record data, which Route 53 requires to be absolute
+func qualifyZoneFileData(recordType string, fields []string, origin string) string {
+	data := append([]string{}, fields...)
+	index := -1
+	switch recordType {
+	case "CNAME", "NS", "PTR":
+		index = 0
+	case "MX":
+		index = 1
+	case "SRV":
+		index = 3
+	}
+	if index >= 0 && index < len(data) {
+		data[index] = qualifyZoneFileName(data[index], origin)
+	}
+	return strings.Join(data, " ")
+}
+
+func isRoute53RecordType(recordType string) bool {
+	for _, v := range r53Types.RRType("").Values() {
+		if string(v) == recordType {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRoute53ZoneFile parses a BIND zone file into record sets, grouping records with the same name and type
+func ParseRoute53ZoneFile(zoneName, content string) ([]r53Types.ResourceRecordSet, error) {
+	lines, err := splitZoneFileLines(content)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := fqdn(strings.ToLower(zoneName))
+	defaultTTL := int64(defaultRoute53ZoneFileTTL)
+	var lastName string
+	var recordSets []r53Types.ResourceRecordSet
+	indexes := make(map[string]int)
+	for _, line := range lines {
+		fields := splitZoneFileFields(line.text)
+		if strings.HasPrefix(fields[0], "$") {
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %v: %v requires a value", line.number, fields[0])
+			}
+			switch strings.ToUpper(fields[0]) {
+			case "$ORIGIN":
+				origin = qualifyZoneFileName(fields[1], origin)
+			case "$TTL":
+				ttl, err := strconv.ParseInt(fields[1], 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %v: invalid TTL %v", line.number, fields[1])
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %v: unsupported directive %v", line.number, fields[0])
+			}
+			continue
+		}
+
+		i := 0
+		name := lastName
+		if !line.continued {
+			name = qualifyZoneFileName(fields[0], origin)
+			i = 1
+		}
+		if name == "" {
+			return nil, fmt.Errorf("line %v: missing record name", line.number)
+		}
+		lastName = name
+
+		// the TTL and class are optional and can appear in either order
+		ttl := defaultTTL
+		for ; i < len(fields); i++ {
+			if v, err := strconv.ParseInt(fields[i], 10, 64); err == nil {
+				ttl = v
+			} else if !strings.EqualFold(fields[i], "IN") {
+				break
+			}
+		}
+		if i >= len(fields)-1 {
+			return nil, fmt.Errorf("line %v: missing record type or data", line.number)
+		}
+		recordType := strings.ToUpper(fields[i])
+		if !isRoute53RecordType(recordType) {
+			return nil, fmt.Errorf("line %v: unsupported record type %v", line.number, fields[i])
+		}
+		value := qualifyZoneFileData(recordType, fields[i+1:], origin)
+
+		// Route 53 has a single TTL per record set, so the first one wins
+		key := name + " " + recordType
+		if index, ok := indexes[key]; ok {
+			recordSets[index].ResourceRecords = append(recordSets[index].ResourceRecords, r53Types.ResourceRecord{Value: aws.String(value)})
+			continue
+		}
+		indexes[key] = len(recordSets)
+		recordSets = append(recordSets, r53Types.ResourceRecordSet{
+			Name:            aws.String(name),
+			Type:            r53Types.RRType(recordType),
+			TTL:             aws.Int64(ttl),
+			ResourceRecords: []r53Types.ResourceRecord{{Value: aws.String(value)}},
+		})
+	}
+	return recordSets, nil
+}
+
+func route53RecordSetKey(v r53Types.ResourceRecordSet) string {
+	return strings.ToLower(UnescapeRoute53Name(aws.ToString(v.Name))) + " " + string(v.Type)
+}
+
+func route53ChangeKey(v r53Types.ResourceRecordSet) string {
+	return route53RecordSetKey(v) + " " + aws.ToString(v.SetIdentifier)
+}
+
+// isRoute53ZoneFileRecord reports whether a record set can be changed by importing a zone file. Alias and routing
+// policy records can't be expressed in one, and the apex SOA and NS records are managed by Route 53.
+func isRoute53ZoneFileRecord(origin string, v r53Types.ResourceRecordSet) bool {
+	if v.AliasTarget != nil || v.SetIdentifier != nil {
+		return false
+	}
+	name := strings.ToLower(UnescapeRoute53Name(aws.ToString(v.Name)))
+	return name != origin || (v.Type != r53Types.RRTypeSoa && v.Type != r53Types.RRTypeNs)
+}
+
+func sameRoute53RecordValues(a, b r53Types.ResourceRecordSet) bool {
+	if aws.ToInt64(a.TTL) != aws.ToInt64(b.TTL) || len(a.ResourceRecords) != len(b.ResourceRecords) {
+		return false
+	}
+	values := func(v r53Types.ResourceRecordSet) []string {
+		var ret []string
+		for _, rr := range v.ResourceRecords {
+			ret = append(ret, aws.ToString(rr.Value))
+		}
+		sort.Strings(ret)
+		return ret
+	}
+	aValues, bValues := values(a), values(b)
+	for i := range aValues {
+		if aValues[i] != bValues[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ComputeRoute53ZoneChanges returns the changes that make the zone's records match the desired ones, with deletes
+// first so that a record can be replaced by one of a conflicting type in the same batch
+func ComputeRoute53ZoneChanges(zoneName string, existing, desired []r53Types.ResourceRecordSet, includeDeletes bool) []r53Types.Change {
+	origin := fqdn(strings.ToLower(zoneName))
+	existingByKey := make(map[string]r53Types.ResourceRecordSet)
+	for _, v := range existing {
+		if isRoute53ZoneFileRecord(origin, v) {
+			existingByKey[route53RecordSetKey(v)] = v
+		}
+	}
+
+	var deletes, upserts, creates []r53Types.Change
+	desiredKeys := make(map[string]bool)
+	for _, v := range desired {
+		if !isRoute53ZoneFileRecord(origin, v) {
+			continue
+		}
+		key := route53RecordSetKey(v)
+		desiredKeys[key] = true
+		recordSet := v
+		if current, ok := existingByKey[key]; !ok {
+			creates = append(creates, r53Types.Change{Action: r53Types.ChangeActionCreate, ResourceRecordSet: &recordSet})
+		} else if !sameRoute53RecordValues(current, v) {
+			upserts = append(upserts, r53Types.Change{Action: r53Types.ChangeActionUpsert, ResourceRecordSet: &recordSet})
+		}
+	}
+	if includeDeletes {
+		for _, v := range existing {
+			if !isRoute53ZoneFileRecord(origin, v) || desiredKeys[route53RecordSetKey(v)] {
+				continue
+			}
+			recordSet := v
+			deletes = append(deletes, r53Types.Change{Action: r53Types.ChangeActionDelete, ResourceRecordSet: &recordSet})
+		}
+	}
+	return append(append(deletes, upserts...), creates...)
+}
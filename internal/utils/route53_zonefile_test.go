@@ -0,0 +1,264 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	r53Types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+func newTestRecordSet(name string, recordType r53Types.RRType, ttl int64, values ...string) r53Types.ResourceRecordSet {
+	var records []r53Types.ResourceRecord
+	for _, v := range values {
+		records = append(records, r53Types.ResourceRecord{Value: aws.String(v)})
+	}
+	return r53Types.ResourceRecordSet{
+		Name:            aws.String(name),
+		Type:            recordType,
+		TTL:             aws.Int64(ttl),
+		ResourceRecords: records,
+	}
+}
+
+func TestUnescapeRoute53Name(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "www.example.com.",
+			expected: "www.example.com.",
+		},
+		{
+			input:    "\\052.example.com.",
+			expected: "*.example.com.",
+		},
+		{
+			input:    "trailing\\05",
+			expected: "trailing\\05",
+		},
+	}
+
+	for _, tc := range tests {
+		got := UnescapeRoute53Name(tc.input)
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestFormatRoute53ZoneFile(t *testing.T) {
+	weighted := newTestRecordSet("api.example.com.", r53Types.RRTypeA, 60, "192.0.2.2")
+	weighted.SetIdentifier = aws.String("blue")
+	weighted.Weight = aws.Int64(10)
+	records := []r53Types.ResourceRecordSet{
+		newTestRecordSet("example.com.", r53Types.RRTypeMx, 300, "10 mail.example.com.", "20 mail2.example.com."),
+		newTestRecordSet("\\052.example.com.", r53Types.RRTypeTxt, 60, "\"v=spf1 -all\""),
+		{
+			Name: aws.String("www.example.com."),
+			Type: r53Types.RRTypeA,
+			AliasTarget: &r53Types.AliasTarget{
+				DNSName:              aws.String("d111111abcdef8.cloudfront.net."),
+				HostedZoneId:         aws.String("Z2FDTNDATAQYW2"),
+				EvaluateTargetHealth: false,
+			},
+		},
+		weighted,
+	}
+	expected := "$ORIGIN example.com.\n" +
+		"example.com.\t300\tIN\tMX\t10 mail.example.com.\n" +
+		"example.com.\t300\tIN\tMX\t20 mail2.example.com.\n" +
+		"*.example.com.\t60\tIN\tTXT\t\"v=spf1 -all\"\n" +
+		"; ALIAS www.example.com. A -> d111111abcdef8.cloudfront.net. (hosted zone Z2FDTNDATAQYW2, evaluate target health false)\n" +
+		"; ROUTING POLICY api.example.com. A (set identifier \"blue\")\n" +
+		"; api.example.com.\t60\tIN\tA\t192.0.2.2\n"
+
+	got := FormatRoute53ZoneFile("example.com", records)
+	if got != expected {
+		t.Fatalf("expected: %v, got: %v", expected, got)
+	}
+}
+
+func TestParseRoute53ZoneFile(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []r53Types.ResourceRecordSet
+		isValid  bool
+	}{
+		{
+			input: "$TTL 3600\n" +
+				"@ IN SOA ns-1.awsdns-00.com. hostmaster.example.com. (\n" +
+				"  1 7200 900 1209600 86400 ) ; serial refresh retry expire minimum\n" +
+				"@ 300 IN MX 10 mail\n" +
+				"  IN 300 MX 20 mail2.example.net.\n" +
+				"www IN CNAME @\n" +
+				"txt.example.com. 60 TXT \"a; b\" \"c\"\n",
+			expected: []r53Types.ResourceRecordSet{
+				newTestRecordSet("example.com.", r53Types.RRTypeSoa, 3600, "ns-1.awsdns-00.com. hostmaster.example.com. 1 7200 900 1209600 86400"),
+				newTestRecordSet("example.com.", r53Types.RRTypeMx, 300, "10 mail.example.com.", "20 mail2.example.net."),
+				newTestRecordSet("www.example.com.", r53Types.RRTypeCname, 3600, "example.com."),
+				newTestRecordSet("txt.example.com.", r53Types.RRTypeTxt, 60, "\"a; b\" \"c\""),
+			},
+			isValid: true,
+		},
+		{
+			input: "$ORIGIN sub.example.com.\n" +
+				"api 60 IN A 192.0.2.1\n",
+			expected: []r53Types.ResourceRecordSet{
+				newTestRecordSet("api.sub.example.com.", r53Types.RRTypeA, 60, "192.0.2.1"),
+			},
+			isValid: true,
+		},
+		{
+			input:   "www IN BOGUS value\n",
+			isValid: false,
+		},
+		{
+			input:   "@ IN SOA ns. host. ( 1 2 3 4 5\n",
+			isValid: false,
+		},
+		{
+			input:   "  IN A 192.0.2.1\n",
+			isValid: false,
+		},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseRoute53ZoneFile("example.com", tc.input)
+		if tc.isValid != (err == nil) {
+			t.Fatalf("expected valid: %v, got error: %v", tc.isValid, err)
+		}
+		if tc.isValid && !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestComputeRoute53ZoneChanges(t *testing.T) {
+	alias := r53Types.ResourceRecordSet{
+		Name:        aws.String("cdn.example.com."),
+		Type:        r53Types.RRTypeA,
+		AliasTarget: &r53Types.AliasTarget{DNSName: aws.String("d111111abcdef8.cloudfront.net.")},
+	}
+	existing := []r53Types.ResourceRecordSet{
+		newTestRecordSet("example.com.", r53Types.RRTypeNs, 172800, "ns-1.awsdns-00.com."),
+		newTestRecordSet("a.example.com.", r53Types.RRTypeA, 300, "192.0.2.1", "192.0.2.2"),
+		newTestRecordSet("b.example.com.", r53Types.RRTypeA, 300, "192.0.2.3"),
+		newTestRecordSet("\\052.example.com.", r53Types.RRTypeA, 300, "192.0.2.4"),
+		alias,
+	}
+	desired := []r53Types.ResourceRecordSet{
+		newTestRecordSet("example.com.", r53Types.RRTypeNs, 300, "ns.example.net."),
+		newTestRecordSet("a.example.com.", r53Types.RRTypeA, 300, "192.0.2.2", "192.0.2.1"),
+		newTestRecordSet("*.example.com.", r53Types.RRTypeA, 60, "192.0.2.4"),
+		newTestRecordSet("c.example.com.", r53Types.RRTypeA, 300, "192.0.2.5"),
+	}
+
+	tests := []struct {
+		includeDeletes bool
+		expected       []string
+	}{
+		{
+			includeDeletes: true,
+			expected:       []string{"DELETE b.example.com.", "UPSERT *.example.com.", "CREATE c.example.com."},
+		},
+		{
+			includeDeletes: false,
+			expected:       []string{"UPSERT *.example.com.", "CREATE c.example.com."},
+		},
+	}
+
+	for _, tc := range tests {
+		var got []string
+		for _, v := range ComputeRoute53ZoneChanges("example.com", existing, desired, tc.includeDeletes) {
+			got = append(got, string(v.Action)+" "+*v.ResourceRecordSet.Name)
+		}
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestFormatRoute53Changes(t *testing.T) {
+	oldRecord := newTestRecordSet("www.example.com.", r53Types.RRTypeA, 300, "192.0.2.1")
+	newRecord := newTestRecordSet("www.example.com.", r53Types.RRTypeA, 60, "192.0.2.2")
+	changes := []r53Types.Change{
+		{Action: r53Types.ChangeActionDelete, ResourceRecordSet: &oldRecord},
+		{Action: r53Types.ChangeActionCreate, ResourceRecordSet: &newRecord},
+	}
+	expected := "- www.example.com.\t300\tIN\tA\t192.0.2.1\n" +
+		"+ www.example.com.\t60\tIN\tA\t192.0.2.2\n"
+
+	got := FormatRoute53Changes(changes)
+	if got != expected {
+		t.Fatalf("expected: %v, got: %v", expected, got)
+	}
+}
+
+func TestCollapseRoute53Changes(t *testing.T) {
+	original := newTestRecordSet("www.example.com.", r53Types.RRTypeA, 300, "192.0.2.1")
+	first := newTestRecordSet("www.example.com.", r53Types.RRTypeA, 300, "192.0.2.2")
+	second := newTestRecordSet("www.example.com.", r53Types.RRTypeA, 300, "192.0.2.3")
+	other := newTestRecordSet("api.example.com.", r53Types.RRTypeA, 300, "192.0.2.4")
+	change := func(action r53Types.ChangeAction, v r53Types.ResourceRecordSet) r53Types.Change {
+		return r53Types.Change{Action: action, ResourceRecordSet: &v}
+	}
+	format := func(changes []r53Types.Change) []string {
+		var ret []string
+		for _, v := range changes {
+			ret = append(ret, string(v.Action)+" "+*v.ResourceRecordSet.Name+" "+*v.ResourceRecordSet.ResourceRecords[0].Value)
+		}
+		return ret
+	}
+
+	tests := []struct {
+		input    []r53Types.Change
+		expected []string
+	}{
+		{
+			// the same record edited twice
+			input: []r53Types.Change{
+				change(r53Types.ChangeActionDelete, original),
+				change(r53Types.ChangeActionCreate, first),
+				change(r53Types.ChangeActionDelete, original),
+				change(r53Types.ChangeActionCreate, second),
+			},
+			expected: []string{"DELETE www.example.com. 192.0.2.1", "CREATE www.example.com. 192.0.2.3"},
+		},
+		{
+			// a record created earlier in the batch, then edited
+			input: []r53Types.Change{
+				change(r53Types.ChangeActionCreate, other),
+				change(r53Types.ChangeActionCreate, first),
+				change(r53Types.ChangeActionDelete, first),
+				change(r53Types.ChangeActionCreate, second),
+			},
+			expected: []string{"CREATE api.example.com. 192.0.2.4", "CREATE www.example.com. 192.0.2.3"},
+		},
+		{
+			// a record edited, then deleted
+			input: []r53Types.Change{
+				change(r53Types.ChangeActionDelete, original),
+				change(r53Types.ChangeActionCreate, first),
+				change(r53Types.ChangeActionDelete, original),
+			},
+			expected: []string{"DELETE www.example.com. 192.0.2.1"},
+		},
+		{
+			input: []r53Types.Change{
+				change(r53Types.ChangeActionDelete, original),
+				change(r53Types.ChangeActionCreate, first),
+				change(r53Types.ChangeActionCreate, other),
+			},
+			expected: []string{"DELETE www.example.com. 192.0.2.1", "CREATE www.example.com. 192.0.2.2", "CREATE api.example.com. 192.0.2.4"},
+		},
+	}
+
+	for _, tc := range tests {
+		got := format(CollapseRoute53Changes(tc.input))
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
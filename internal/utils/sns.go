@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	snsTypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"sort"
+)
+
+// ValidateSNSMessageStructure checks a per-protocol message, which must be a JSON object of strings
+// with at least a default message
+func ValidateSNSMessageStructure(message string) error {
+	var messages map[string]any
+	if err := json.Unmarshal([]byte(message), &messages); err != nil {
+		return errors.New("message must be a JSON object keyed by protocol")
+	}
+	if _, ok := messages["default"]; !ok {
+		return errors.New("message must include a default key")
+	}
+	var keys []string
+	for k := range messages {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if _, ok := messages[k].(string); !ok {
+			return fmt.Errorf("message for %v must be a string", k)
+		}
+	}
+	return nil
+}
+
+// ParseSNSMessageAttributes converts a JSON object into message attributes. Strings become String attributes,
+// numbers become Number attributes and arrays become String.Array attributes.
+func ParseSNSMessageAttributes(data string) (map[string]snsTypes.MessageAttributeValue, error) {
+	attributes := make(map[string]snsTypes.MessageAttributeValue)
+	if len(bytes.TrimSpace([]byte(data))) == 0 {
+		return attributes, nil
+	}
+	var values map[string]json.RawMessage
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return attributes, errors.New("message attributes must be a JSON object")
+	}
+	for k, raw := range values {
+		var value any
+		d := json.NewDecoder(bytes.NewReader(raw))
+		d.UseNumber()
+		if err := d.Decode(&value); err != nil {
+			return attributes, err
+		}
+		switch v := value.(type) {
+		case string:
+			attributes[k] = snsTypes.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(v)}
+		case json.Number:
+			attributes[k] = snsTypes.MessageAttributeValue{DataType: aws.String("Number"), StringValue: aws.String(v.String())}
+		case []any:
+			attributes[k] = snsTypes.MessageAttributeValue{DataType: aws.String("String.Array"), StringValue: aws.String(string(raw))}
+		default:
+			return attributes, fmt.Errorf("message attribute %v must be a string, number or array", k)
+		}
+	}
+	return attributes, nil
+}
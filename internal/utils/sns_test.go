@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestValidateSNSMessageStructure(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    `{"default": "hello", "sqs": "{\"a\": 1}"}`,
+			expected: true,
+		},
+		{
+			input:    `{"sqs": "hello"}`,
+			expected: false,
+		},
+		{
+			input:    `{"default": {"a": 1}}`,
+			expected: false,
+		},
+		{
+			input:    `hello`,
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		got := ValidateSNSMessageStructure(tc.input) == nil
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestParseSNSMessageAttributes(t *testing.T) {
+	tests := []struct {
+		input     string
+		name      string
+		dataType  string
+		value     string
+		expectErr bool
+	}{
+		{
+			input:    `{"event": "created"}`,
+			name:     "event",
+			dataType: "String",
+			value:    "created",
+		},
+		{
+			input:    `{"price": 10.50}`,
+			name:     "price",
+			dataType: "Number",
+			value:    "10.50",
+		},
+		{
+			input:    `{"tags": ["a", "b"]}`,
+			name:     "tags",
+			dataType: "String.Array",
+			value:    `["a", "b"]`,
+		},
+		{
+			input:     `{"flag": true}`,
+			expectErr: true,
+		},
+		{
+			input:     `[1, 2]`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseSNSMessageAttributes(tc.input)
+		if tc.expectErr {
+			if err == nil {
+				t.Fatalf("expected error for %v", tc.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		attr, ok := got[tc.name]
+		if !ok {
+			t.Fatalf("expected: attribute %v, got: %v", tc.name, got)
+		}
+		if *attr.DataType != tc.dataType || *attr.StringValue != tc.value {
+			t.Fatalf("expected: %v %v, got: %v %v", tc.dataType, tc.value, *attr.DataType, *attr.StringValue)
+		}
+	}
+}
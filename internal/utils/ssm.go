@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+)
+
+// GetSSMPathChildren splits parameter names under a path ending in / into the paths of its immediate
+// sub-hierarchies and the names of the parameters directly under it
+func GetSSMPathChildren(path string, names []string) ([]string, []string) {
+	seen := make(map[string]bool)
+	var dirs, leaves []string
+	for _, name := range names {
+		if !strings.HasPrefix(name, path) {
+			continue
+		}
+		rest := name[len(path):]
+		if i := strings.Index(rest, "/"); i >= 0 {
+			dir := path + rest[:i+1]
+			if !seen[dir] {
+				seen[dir] = true
+				dirs = append(dirs, dir)
+			}
+		} else if rest != "" {
+			leaves = append(leaves, name)
+		}
+	}
+	sort.Strings(dirs)
+	sort.Strings(leaves)
+	return dirs, leaves
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetSSMPathChildren(t *testing.T) {
+	names := []string{
+		"/app/prod/db/password",
+		"/app/prod/db/user",
+		"/app/prod/url",
+		"/app/dev/url",
+		"/other",
+	}
+	tests := []struct {
+		path           string
+		expectedDirs   []string
+		expectedLeaves []string
+	}{
+		{
+			path:           "/",
+			expectedDirs:   []string{"/app/"},
+			expectedLeaves: []string{"/other"},
+		},
+		{
+			path:           "/app/",
+			expectedDirs:   []string{"/app/dev/", "/app/prod/"},
+			expectedLeaves: nil,
+		},
+		{
+			path:           "/app/prod/",
+			expectedDirs:   []string{"/app/prod/db/"},
+			expectedLeaves: []string{"/app/prod/url"},
+		},
+	}
+
+	for _, tc := range tests {
+		dirs, leaves := GetSSMPathChildren(tc.path, names)
+		if !reflect.DeepEqual(dirs, tc.expectedDirs) {
+			t.Fatalf("expected: %v, got: %v", tc.expectedDirs, dirs)
+		}
+		if !reflect.DeepEqual(leaves, tc.expectedLeaves) {
+			t.Fatalf("expected: %v, got: %v", tc.expectedLeaves, leaves)
+		}
+	}
+}
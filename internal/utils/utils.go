@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"bytes"
 	"crypto/x509"
 	"encoding/pem"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -8,8 +9,10 @@ import (
 	"golang.org/x/text/language"
 	"math"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 const (
@@ -164,3 +167,68 @@ func TruncateStrings(items []string, count int) string {
 	}
 	return strings.Join(items[:count], ", ") + " + " + strconv.Itoa(diff) + " more"
 }
+
+// IsBinary reports whether data looks like binary rather than text content
+func IsBinary(data []byte) bool {
+	if len(data) > 8000 {
+		data = data[:8000]
+		// drop a rune split by the cut, which would otherwise make valid text look invalid
+		for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+			if utf8.RuneStart(data[i]) {
+				if !utf8.FullRune(data[i:]) {
+					data = data[:i]
+				}
+				break
+			}
+		}
+	}
+	if bytes.IndexByte(data, 0) >= 0 {
+		return true
+	}
+	return !utf8.Valid(data)
+}
+
+var durationUnits = []struct {
+	suffix  string
+	seconds int64
+}{
+	{"d", 86400},
+	{"h", 3600},
+	{"m", 60},
+	{"s", 1},
+}
+
+// FormatSeconds formats a number of seconds using its two largest units, e.g. 1d 2h
+func FormatSeconds(seconds int64) string {
+	if seconds == 0 {
+		return "0s"
+	}
+	var parts []string
+	for _, unit := range durationUnits {
+		if seconds >= unit.seconds {
+			parts = append(parts, strconv.FormatInt(seconds/unit.seconds, 10)+unit.suffix)
+			seconds %= unit.seconds
+		}
+		if len(parts) == 2 {
+			break
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// DiffStringMaps gets the sorted keys whose values differ between the two maps, treating a missing key as empty
+func DiffStringMaps(left map[string]string, right map[string]string) []string {
+	var keys []string
+	for k, v := range left {
+		if right[k] != v {
+			keys = append(keys, k)
+		}
+	}
+	for k, v := range right {
+		if _, ok := left[k]; !ok && v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
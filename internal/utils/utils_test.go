@@ -2,6 +2,8 @@ package utils
 
 import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -203,3 +205,110 @@ func TestTruncateStrings(t *testing.T) {
 		}
 	}
 }
+
+func TestIsBinary(t *testing.T) {
+	tests := []struct {
+		input    []byte
+		expected bool
+	}{
+		{
+			input:    []byte("exports.handler = async (event) => {}\n"),
+			expected: false,
+		},
+		{
+			input:    []byte("héllo wörld"),
+			expected: false,
+		},
+		{
+			input:    []byte{0x7f, 'E', 'L', 'F', 0x02, 0x01, 0x00},
+			expected: true,
+		},
+		{
+			input:    []byte{0xff, 0xfe, 0xfd},
+			expected: true,
+		},
+		{
+			// a multi-byte rune split by the length limit
+			input:    []byte(strings.Repeat("a", 7999) + "é"),
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		got := IsBinary(tc.input)
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestFormatSeconds(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{
+			input:    0,
+			expected: "0s",
+		},
+		{
+			input:    30,
+			expected: "30s",
+		},
+		{
+			input:    90,
+			expected: "1m 30s",
+		},
+		{
+			input:    345600,
+			expected: "4d",
+		},
+		{
+			input:    93784,
+			expected: "1d 2h",
+		},
+	}
+
+	for _, tc := range tests {
+		got := FormatSeconds(tc.input)
+		if got != tc.expected {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}
+
+func TestDiffStringMaps(t *testing.T) {
+	tests := []struct {
+		left     map[string]string
+		right    map[string]string
+		expected []string
+	}{
+		{
+			left:     map[string]string{"a": "1", "b": "2"},
+			right:    map[string]string{"a": "1", "b": "2"},
+			expected: nil,
+		},
+		{
+			left:     map[string]string{"a": "1", "b": "2"},
+			right:    map[string]string{"a": "1", "b": "3"},
+			expected: []string{"b"},
+		},
+		{
+			left:     map[string]string{"c": "1", "a": ""},
+			right:    map[string]string{"b": "2"},
+			expected: []string{"b", "c"},
+		},
+		{
+			left:     map[string]string{},
+			right:    map[string]string{"a": ""},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		got := DiffStringMaps(tc.left, tc.right)
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("expected: %v, got: %v", tc.expected, got)
+		}
+	}
+}